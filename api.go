@@ -18,7 +18,7 @@ type IPubRest interface {
 	//@parameter
 	//  - bashSym
 	//  - quoteSym
-	//	- opts 交割合约的时候传入contract alias name: this_week,next_week, quarter ...
+	//	- opts 交割合约的时候传入contract alias name: this_quarter, next_quarter ...
 	NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error)
 }
 
@@ -39,6 +39,46 @@ type IPrvRest interface {
 
 type ISpotPrvRest interface {
 	IPrvRest
+	//AmendOrder 修改未成交订单的数量和价格
+	//@returns
+	//  order        修改后的订单
+	//  responseBody 交易所接口返回的原始字节数据
+	//  err          错误
+	AmendOrder(pair model.CurrencyPair, id string, newQty, newPrice float64, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error)
+	//CreateOrders 批量下单
+	//@returns
+	//  results 与reqs一一对应的下单结果，单个订单失败不影响其他订单
+	//  err     整体性错误，如参数为空
+	CreateOrders(reqs []model.BatchOrderReq) (results []model.BatchOrderResult, err error)
+	//CancelOrders 批量撤单，results与ids一一对应
+	CancelOrders(pair model.CurrencyPair, ids []string, opt ...model.OptionParameter) (results []model.BatchOrderResult, err error)
+	//GetFills 获取成交明细
+	//@parameter
+	//  - opts fromId或startTime、endTime，传入时自动翻页
+	GetFills(pair model.CurrencyPair, opts ...model.OptionParameter) (fills []model.Fill, responseBody []byte, err error)
+}
+
+// ISpotPubRest 现货扩展公共接口，包括成交记录、归集成交、平均价格、最优挂单、滚动窗口行情、UI K线等
+type ISpotPubRest interface {
+	IPubRest
+	//GetTrades 获取近期成交
+	GetTrades(pair model.CurrencyPair, limit int, opts ...model.OptionParameter) (trades []model.Trade, responseBody []byte, err error)
+	//GetHistoricalTrades 获取历史成交
+	//@parameter
+	//  - fromId 从该成交ID开始返回，为空时返回最近的成交
+	GetHistoricalTrades(pair model.CurrencyPair, fromId string, limit int, opts ...model.OptionParameter) (trades []model.Trade, responseBody []byte, err error)
+	//GetAggTrades 获取归集成交
+	GetAggTrades(pair model.CurrencyPair, opts ...model.OptionParameter) (trades []model.AggTrade, responseBody []byte, err error)
+	//GetAvgPrice 获取当前平均价格
+	GetAvgPrice(pair model.CurrencyPair) (avg *model.AvgPrice, responseBody []byte, err error)
+	//GetBookTicker 获取最优挂单
+	GetBookTicker(pair model.CurrencyPair, opts ...model.OptionParameter) (book *model.BookTicker, responseBody []byte, err error)
+	//GetRollingTicker 获取滚动窗口行情
+	//@parameter
+	//  - windowSize 1m-59m、1h-23h、1d-7d
+	GetRollingTicker(pair model.CurrencyPair, windowSize string, opts ...model.OptionParameter) (ticker *model.Ticker, responseBody []byte, err error)
+	//GetUIKline 获取针对图表展示优化的K线
+	GetUIKline(pair model.CurrencyPair, period model.KlinePeriod, opts ...model.OptionParameter) (klines []model.Kline, responseBody []byte, err error)
 }
 
 type IFuturesPubRest interface {
@@ -60,3 +100,41 @@ type IFuturesPrvRest interface {
 	//	err          错误
 	GetPositions(pair model.CurrencyPair, opts ...model.OptionParameter) (positions []model.FuturesPosition, responseBody []byte, err error)
 }
+
+// IFuturesTradePrvRest 期货扩展私有接口，包括杠杆、保证金模式、持仓模式、改单、批量下单、资金流水、成交明细等
+type IFuturesTradePrvRest interface {
+	IFuturesPrvRest
+	//SetLeverage 设置交易对的杠杆倍数
+	SetLeverage(pair model.CurrencyPair, lever int, opts ...model.OptionParameter) (responseBody []byte, err error)
+	//GetLeverage 获取交易对当前的杠杆倍数
+	GetLeverage(pair model.CurrencyPair, opts ...model.OptionParameter) (lever int, responseBody []byte, err error)
+	//GetLeverageBrackets 获取交易对的杠杆分层标准(各名义价值区间允许的最高杠杆)
+	GetLeverageBrackets(pair model.CurrencyPair, opts ...model.OptionParameter) (brackets []model.LeverageBracket, responseBody []byte, err error)
+	//SetMarginType 设置保证金模式
+	//@parameter
+	//  - marginType model.ISOLATED_MARGIN_TYPE / model.CROSSED_MARGIN_TYPE
+	SetMarginType(pair model.CurrencyPair, marginType string, opts ...model.OptionParameter) (responseBody []byte, err error)
+	//ModifyIsolatedPositionMargin 调整逐仓保证金
+	//@parameter
+	//  - amount 大于0增加保证金，小于0减少保证金
+	ModifyIsolatedPositionMargin(pair model.CurrencyPair, amount float64, opts ...model.OptionParameter) (responseBody []byte, err error)
+	//SetPositionMode 设置持仓模式
+	//@parameter
+	//  - mode model.TWO_WAY_POSITION_MODE(双向持仓) / model.ONE_WAY_POSITION_MODE(单向持仓)
+	SetPositionMode(mode string, opts ...model.OptionParameter) (responseBody []byte, err error)
+	//GetPositionMode 获取当前持仓模式
+	GetPositionMode(opts ...model.OptionParameter) (mode string, responseBody []byte, err error)
+	//AmendOrder 修改未成交订单的数量和价格
+	AmendOrder(pair model.CurrencyPair, id string, newQty, newPrice float64, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error)
+	//CreateOrders 批量下单，results与reqs一一对应
+	CreateOrders(reqs []model.BatchOrderReq) (results []model.BatchOrderResult, err error)
+	//CancelOrders 批量撤单，results与ids一一对应
+	CancelOrders(pair model.CurrencyPair, ids []string, opt ...model.OptionParameter) (results []model.BatchOrderResult, err error)
+	//GetIncomeHistory 获取资金流水(已实现盈亏、资金费、手续费等)
+	//@parameter
+	//  - incomeType 流水类型，为空时返回所有类型
+	//  - opts       symbol、startTime、endTime等
+	GetIncomeHistory(incomeType model.IncomeType, opts ...model.OptionParameter) (incomes []model.Income, responseBody []byte, err error)
+	//GetFills 获取成交明细，包含已实现盈亏
+	GetFills(pair model.CurrencyPair, opts ...model.OptionParameter) (fills []model.Fill, responseBody []byte, err error)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/nntaoli-project/goex/v2/logger"
 	"github.com/nntaoli-project/goex/v2/model"
 	"net/url"
+	"strings"
 )
 
 func AdaptKlinePeriodToSymbol(period model.KlinePeriod) string {
@@ -32,12 +33,51 @@ func AdaptOrderTypeToString(ty model.OrderType) string {
 		return "LIMIT"
 	case model.OrderType_Market:
 		return "MARKET"
+	case model.OrderType_Stop:
+		return "STOP"
+	case model.OrderType_StopMarket:
+		return "STOP_MARKET"
+	case model.OrderType_TakeProfit:
+		return "TAKE_PROFIT"
+	case model.OrderType_TakeProfitMarket:
+		return "TAKE_PROFIT_MARKET"
+	case model.OrderType_TrailingStopMarket:
+		return "TRAILING_STOP_MARKET"
 	default:
 		logger.Warnf("[adapt order type] order typ unknown")
 	}
 	return string(ty)
 }
 
+// IsLimitOrderType 是否为需要价格和timeInForce的限价类订单
+func IsLimitOrderType(ty model.OrderType) bool {
+	switch ty {
+	case model.OrderType_Limit, model.OrderType_Stop, model.OrderType_TakeProfit,
+		model.OrderType_StopLossLimit, model.OrderType_TakeProfitLimit:
+		return true
+	}
+	return false
+}
+
+// IsPricedOrderType 是否为需要价格的订单，包括限价类订单和只做maker的LIMIT_MAKER，LIMIT_MAKER不需要timeInForce
+func IsPricedOrderType(ty model.OrderType) bool {
+	return IsLimitOrderType(ty) || ty == model.OrderType_LimitMaker
+}
+
+// IsReduceOnlyOrder 是否为只减仓订单，包括合约平仓方向(Futures_CloseBuy/Futures_CloseSell)和opts中传入reduceOnly=true的订单
+// 币安不对只减仓订单校验最小名义价值，否则名义价值很小的剩余仓位无法平仓
+func IsReduceOnlyOrder(side model.OrderSide, opts ...model.OptionParameter) bool {
+	if side == model.Futures_CloseBuy || side == model.Futures_CloseSell {
+		return true
+	}
+	for _, opt := range opts {
+		if opt.Key == "reduceOnly" {
+			return opt.Value == "true"
+		}
+	}
+	return false
+}
+
 func AdaptOrderSideToString(s model.OrderSide) string {
 	switch s {
 	case model.Spot_Buy, model.Futures_OpenBuy, model.Futures_CloseSell:
@@ -86,17 +126,60 @@ func AdaptStringToFuturesOrderSide(side, positionSide string) model.OrderSide {
 	return model.OrderSide(side)
 }
 
+// AdaptOneWayStringToFuturesOrderSide 单向持仓模式(positionSide=BOTH)下根据side和reduceOnly确定订单方向
+func AdaptOneWayStringToFuturesOrderSide(side string, reduceOnly bool) model.OrderSide {
+	switch side {
+	case "BUY":
+		if reduceOnly {
+			return model.Futures_CloseSell
+		}
+		return model.Futures_OpenBuy
+	case "SELL":
+		if reduceOnly {
+			return model.Futures_CloseBuy
+		}
+		return model.Futures_OpenSell
+	default:
+		logger.Warnf("[adaptOrderOrigSide] unknown order origin side: %s", side)
+	}
+	return model.OrderSide(side)
+}
+
 func AdaptStringToOrderType(ty string) model.OrderType {
 	switch ty {
 	case "LIMIT":
 		return model.OrderType_Limit
 	case "MARKET":
 		return model.OrderType_Market
+	case "STOP":
+		return model.OrderType_Stop
+	case "STOP_MARKET":
+		return model.OrderType_StopMarket
+	case "TAKE_PROFIT":
+		return model.OrderType_TakeProfit
+	case "TAKE_PROFIT_MARKET":
+		return model.OrderType_TakeProfitMarket
+	case "TRAILING_STOP_MARKET":
+		return model.OrderType_TrailingStopMarket
 	default:
 		return model.OrderType(ty)
 	}
 }
 
+// AdaptContractTypeToAlias 将币安合约类型(contractType)转换为合约alias
+// 同时兼容直接传入alias，如this_quarter、CURRENT_QUARTER都会转换为model.THIS_QUARTER_CONTRACT
+func AdaptContractTypeToAlias(contractType string) string {
+	switch strings.ToUpper(contractType) {
+	case "PERPETUAL":
+		return model.PERPETUAL_CONTRACT
+	case "CURRENT_QUARTER", "THIS_QUARTER", "QUARTER":
+		return model.THIS_QUARTER_CONTRACT
+	case "NEXT_QUARTER":
+		return model.NEXT_QUARTER_CONTRACT
+	}
+	return contractType
+}
+
 func AdaptOrderClientIDOptionParameter(params *url.Values) {
 	cid := params.Get(model.Order_Client_ID__Opt_Key)
 	if cid != "" {
@@ -104,3 +187,13 @@ func AdaptOrderClientIDOptionParameter(params *url.Values) {
 		params.Del(model.Order_Client_ID__Opt_Key)
 	}
 }
+
+// IsTestOrder 是否使用测试下单接口，opts中的TestOrder参数优先于实例的测试模式testMode
+func IsTestOrder(testMode bool, opts ...model.OptionParameter) bool {
+	for _, opt := range opts {
+		if opt.Key == model.Test_Order__Opt_Key {
+			return opt.Value == "true"
+		}
+	}
+	return testMode
+}
@@ -0,0 +1,81 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"time"
+)
+
+// IsContractDelivered 交割合约是否已到交割时间，永续合约始终返回false
+func IsContractDelivered(pair model.CurrencyPair, now time.Time) bool {
+	return pair.ContractAlias != model.PERPETUAL_CONTRACT &&
+		pair.ContractDeliveryDate > 0 &&
+		pair.ContractDeliveryDate <= now.UnixMilli()
+}
+
+// RollContract 按BaseSymbol+QuoteSymbol+alias查找未到期的合约
+// 参数:
+//   - currencyPairM: GetExchangeInfo返回的交易对信息
+//   - baseSym, quoteSym: 币种和计价币种
+//   - alias: 合约alias，如model.THIS_QUARTER_CONTRACT
+//   - now: 当前时间
+//
+// 返回值:
+//   - model.CurrencyPair: 合约信息
+//   - bool: 是否找到未到期的合约
+//
+// 注意:
+//   - 当季合约到期而交易对信息尚未刷新时，顺延到缓存中的次季合约
+func RollContract(currencyPairM map[string]model.CurrencyPair, baseSym, quoteSym, alias string, now time.Time) (model.CurrencyPair, bool) {
+	pair, ok := currencyPairM[baseSym+quoteSym+alias]
+	if ok && !IsContractDelivered(pair, now) {
+		return pair, true
+	}
+
+	if alias == model.THIS_QUARTER_CONTRACT {
+		next, ok := currencyPairM[baseSym+quoteSym+model.NEXT_QUARTER_CONTRACT]
+		if ok && !IsContractDelivered(next, now) {
+			next.ContractAlias = alias
+			return next, true
+		}
+	}
+
+	return pair, false
+}
+
+// ResolveContract 从交易对信息注册表中查找合约，U本位和币本位合约的NewCurrencyPair共用
+// 参数:
+//   - registry: 交易对信息注册表，未加载时先加载
+//   - baseSym, quoteSym: 币种和计价币种
+//   - alias: 合约alias，如model.THIS_QUARTER_CONTRACT
+//   - now: 当前时间
+//
+// 返回值:
+//   - model.CurrencyPair: 合约信息
+//   - error: 加载失败或找不到未到期的合约时的错误信息
+//
+// 注意:
+//   - 缓存的合约已交割时先重新获取交易对信息，返回alias对应的新合约
+//   - 重新获取失败时按RollContract的规则，当季合约顺延到缓存中的次季合约
+func ResolveContract(registry *SymbolRegistry, baseSym, quoteSym, alias string, now time.Time) (model.CurrencyPair, error) {
+	pairs, err := registry.Load()
+	if err != nil {
+		return model.CurrencyPair{}, fmt.Errorf("load exchange info error: %w", err)
+	}
+
+	if cached, ok := pairs[baseSym+quoteSym+alias]; ok && IsContractDelivered(cached, now) {
+		if refreshed, _, err := registry.Refresh(); err != nil {
+			logger.Warnf("[NewCurrencyPair] refresh exchange info error: %s", err.Error())
+		} else {
+			pairs = refreshed
+		}
+	}
+
+	pair, ok := RollContract(pairs, baseSym, quoteSym, alias, now)
+	if !ok {
+		return model.CurrencyPair{}, errors.New("not found currency pair")
+	}
+	return pair, nil
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"testing"
+	"time"
+)
+
+func TestRollContract(t *testing.T) {
+	var (
+		now      = time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC)
+		delivery = now.UnixMilli() //2024-06-28 08:00 UTC交割
+
+		perpetual   = model.CurrencyPair{Symbol: "BTCUSDT", BaseSymbol: "BTC", QuoteSymbol: "USDT", ContractAlias: model.PERPETUAL_CONTRACT}
+		thisQuarter = model.CurrencyPair{Symbol: "BTCUSDT_240628", BaseSymbol: "BTC", QuoteSymbol: "USDT", ContractAlias: model.THIS_QUARTER_CONTRACT, ContractDeliveryDate: delivery}
+		nextQuarter = model.CurrencyPair{Symbol: "BTCUSDT_240927", BaseSymbol: "BTC", QuoteSymbol: "USDT", ContractAlias: model.NEXT_QUARTER_CONTRACT, ContractDeliveryDate: time.Date(2024, 9, 27, 8, 0, 0, 0, time.UTC).UnixMilli()}
+		pairs       = map[string]model.CurrencyPair{
+			"BTCUSDTPERPETUAL":    perpetual,
+			"BTCUSDTthis_quarter": thisQuarter,
+			"BTCUSDTnext_quarter": nextQuarter,
+		}
+	)
+
+	rolled := nextQuarter
+	rolled.ContractAlias = model.THIS_QUARTER_CONTRACT
+
+	tests := []struct {
+		name       string
+		base       string
+		alias      string
+		now        time.Time
+		wantSymbol string
+		wantAlias  string
+		wantOk     bool
+	}{
+		{name: "perpetual never delivers", alias: model.PERPETUAL_CONTRACT, now: now.AddDate(10, 0, 0), wantSymbol: perpetual.Symbol, wantAlias: model.PERPETUAL_CONTRACT, wantOk: true},
+		{name: "this quarter before delivery", alias: model.THIS_QUARTER_CONTRACT, now: now.Add(-time.Millisecond), wantSymbol: thisQuarter.Symbol, wantAlias: model.THIS_QUARTER_CONTRACT, wantOk: true},
+		{name: "this quarter rolls at delivery", alias: model.THIS_QUARTER_CONTRACT, now: now, wantSymbol: rolled.Symbol, wantAlias: model.THIS_QUARTER_CONTRACT, wantOk: true},
+		{name: "next quarter before delivery", alias: model.NEXT_QUARTER_CONTRACT, now: now, wantSymbol: nextQuarter.Symbol, wantAlias: model.NEXT_QUARTER_CONTRACT, wantOk: true},
+		{name: "next quarter delivered does not roll", alias: model.NEXT_QUARTER_CONTRACT, now: now.AddDate(0, 4, 0), wantSymbol: nextQuarter.Symbol, wantAlias: model.NEXT_QUARTER_CONTRACT},
+		{name: "both quarters delivered", alias: model.THIS_QUARTER_CONTRACT, now: now.AddDate(0, 4, 0), wantSymbol: thisQuarter.Symbol, wantAlias: model.THIS_QUARTER_CONTRACT},
+		{name: "unknown pair", base: "ETH", alias: model.THIS_QUARTER_CONTRACT, now: now},
+	}
+
+	for _, tt := range tests {
+		base := tt.base
+		if base == "" {
+			base = "BTC"
+		}
+		pair, ok := RollContract(pairs, base, "USDT", tt.alias, tt.now)
+		if ok != tt.wantOk || pair.Symbol != tt.wantSymbol || pair.ContractAlias != tt.wantAlias {
+			t.Errorf("%s: RollContract() = %s(%s), %v, want %s(%s), %v", tt.name, pair.Symbol, pair.ContractAlias, ok, tt.wantSymbol, tt.wantAlias, tt.wantOk)
+		}
+	}
+}
+
+func TestAdaptContractTypeToAlias(t *testing.T) {
+	tests := map[string]string{
+		"PERPETUAL":                 model.PERPETUAL_CONTRACT,
+		"CURRENT_QUARTER":           model.THIS_QUARTER_CONTRACT,
+		"current_quarter":           model.THIS_QUARTER_CONTRACT,
+		"QUARTER":                   model.THIS_QUARTER_CONTRACT,
+		model.THIS_QUARTER_CONTRACT: model.THIS_QUARTER_CONTRACT,
+		"NEXT_QUARTER":              model.NEXT_QUARTER_CONTRACT,
+		model.NEXT_QUARTER_CONTRACT: model.NEXT_QUARTER_CONTRACT,
+		"PERPETUAL_DELIVERING":      "PERPETUAL_DELIVERING",
+	}
+
+	for in, want := range tests {
+		if got := AdaptContractTypeToAlias(in); got != want {
+			t.Errorf("AdaptContractTypeToAlias(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
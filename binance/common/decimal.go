@@ -0,0 +1,24 @@
+package common
+
+import (
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+)
+
+// GetDecimal 按路径读取币安返回的字符串或数字并转换为精确十进制数，不存在或格式错误时返回0
+// 参数:
+//   - data: JSON数据
+//   - keys: jsonparser路径，数组元素使用"[1]"
+//
+// 使用示例:
+//
+//	price := common.GetDecimal(data, "price")
+//	open := common.GetDecimal(klineData, "[1]")
+func GetDecimal(data []byte, keys ...string) model.Decimal {
+	val, _, _, err := jsonparser.Get(data, keys...)
+	if err != nil {
+		return model.Decimal{}
+	}
+	d, _ := model.NewDecimal(string(val))
+	return d
+}
@@ -0,0 +1,267 @@
+package common
+
+import (
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"github.com/spf13/cast"
+	"net/url"
+)
+
+// UnmarshalSymbolFilters 解析交易所信息中单个交易对的filters数组，现货、U本位和币本位合约共用
+// 参数:
+//   - data: filters数组的原始数据
+//
+// 返回值:
+//   - model.SymbolFilters: 交易规则过滤器，未出现的过滤器字段为0
+//
+// 注意:
+//   - 现货MIN_NOTIONAL的字段为minNotional/applyToMarket，U本位合约为notional且同样适用于市价单
+//   - PERCENT_PRICE的multiplierUp/multiplierDown同时用作买卖两个方向的倍数
+func UnmarshalSymbolFilters(data []byte) model.SymbolFilters {
+	var filters model.SymbolFilters
+
+	_, _ = jsonparser.ArrayEach(data, func(filterData []byte, dataType jsonparser.ValueType, offset int, err error) {
+		getFloat := func(key string) float64 {
+			val, _ := jsonparser.GetString(filterData, key)
+			return cast.ToFloat64(val)
+		}
+		getBool := func(key string) bool {
+			val, _ := jsonparser.GetBoolean(filterData, key)
+			return val
+		}
+
+		filterType, _ := jsonparser.GetString(filterData, "filterType")
+		switch filterType {
+		case "PRICE_FILTER":
+			filters.MinPrice = getFloat("minPrice")
+			filters.MaxPrice = getFloat("maxPrice")
+			filters.TickSize = getFloat("tickSize")
+		case "LOT_SIZE":
+			filters.MinQty = getFloat("minQty")
+			filters.MaxQty = getFloat("maxQty")
+			filters.StepSize = getFloat("stepSize")
+		case "MARKET_LOT_SIZE":
+			filters.MarketMinQty = getFloat("minQty")
+			filters.MarketMaxQty = getFloat("maxQty")
+			filters.MarketStepSize = getFloat("stepSize")
+		case "MIN_NOTIONAL":
+			if notional := getFloat("notional"); notional > 0 { //U本位合约
+				filters.MinNotional = notional
+				filters.ApplyMinToMarket = true
+			} else {
+				filters.MinNotional = getFloat("minNotional")
+				filters.ApplyMinToMarket = getBool("applyToMarket")
+			}
+		case "NOTIONAL":
+			filters.MinNotional = getFloat("minNotional")
+			filters.MaxNotional = getFloat("maxNotional")
+			filters.ApplyMinToMarket = getBool("applyMinToMarket")
+			filters.ApplyMaxToMarket = getBool("applyMaxToMarket")
+		case "PERCENT_PRICE":
+			filters.BidMultiplierUp = getFloat("multiplierUp")
+			filters.BidMultiplierDown = getFloat("multiplierDown")
+			filters.AskMultiplierUp = filters.BidMultiplierUp
+			filters.AskMultiplierDown = filters.BidMultiplierDown
+		case "PERCENT_PRICE_BY_SIDE":
+			filters.BidMultiplierUp = getFloat("bidMultiplierUp")
+			filters.BidMultiplierDown = getFloat("bidMultiplierDown")
+			filters.AskMultiplierUp = getFloat("askMultiplierUp")
+			filters.AskMultiplierDown = getFloat("askMultiplierDown")
+		}
+	})
+
+	return filters
+}
+
+// NormalizeOrder 下单前按交易对的交易规则规整价格和数量，并在本地校验，避免请求交易所后才被拒绝
+// 参数:
+//   - pair: 交易对，Filters来自GetExchangeInfo
+//   - qty: 下单数量
+//   - price: 下单价格，不需要价格的订单(见IsPricedOrderType)忽略
+//   - side: 订单方向，用于选择PERCENT_PRICE_BY_SIDE的买卖倍数
+//   - orderTy: 订单类型，市价类订单使用MARKET_LOT_SIZE校验数量
+//   - refPrice: 参考价格，如平均价格或标记价格，用于市价单名义价值和PERCENT_PRICE校验，为0时跳过这两项校验
+//   - opts: 下单的可选参数，包含reduceOnly=true时视为只减仓订单
+//
+// 返回值:
+//   - float64: 规整后的数量，按stepSize向下取整，避免超出可用余额
+//   - float64: 规整后的价格，按tickSize四舍五入
+//   - error: 违反交易规则时的错误信息，包含交易对、过滤器名称和限制值
+//
+// 注意:
+//   - 过滤器字段为0表示交易所未设置该限制，不做校验，因此手动构造的交易对不受影响
+//   - 只减仓订单(见IsReduceOnlyOrder)不校验名义价值，与交易所规则一致
+//
+// 使用示例:
+//
+//	qty, price, err := common.NormalizeOrder(pair, 0.0123456, 30000.27, model.Spot_Buy, model.OrderType_Limit, 0)
+func NormalizeOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, refPrice float64, opts ...model.OptionParameter) (float64, float64, error) {
+	var (
+		isMarket   = !IsPricedOrderType(orderTy)
+		reduceOnly = IsReduceOnlyOrder(side, opts...)
+	)
+
+	qty, err := NormalizeQty(pair, qty, isMarket)
+	if err != nil {
+		return qty, price, err
+	}
+
+	if isMarket {
+		if reduceOnly {
+			return qty, price, nil
+		}
+		return qty, price, CheckNotional(pair, qty, refPrice, true)
+	}
+
+	price, err = NormalizePrice(pair, price)
+	if err != nil {
+		return qty, price, err
+	}
+
+	if err = CheckPercentPrice(pair, side, price, refPrice); err != nil {
+		return qty, price, err
+	}
+
+	if reduceOnly {
+		return qty, price, nil
+	}
+	return qty, price, CheckNotional(pair, qty, price, false)
+}
+
+// NormalizePrice 按PRICE_FILTER把价格四舍五入为tickSize的整数倍，并校验最低/最高价格
+func NormalizePrice(pair model.CurrencyPair, price float64) (float64, error) {
+	f := pair.Filters
+	price = util.RoundToStep(price, f.TickSize)
+
+	if f.MinPrice > 0 && price < f.MinPrice {
+		return price, fmt.Errorf("%s price %s < PRICE_FILTER minPrice %s", pair.Symbol, formatFloat(price), formatFloat(f.MinPrice))
+	}
+	if f.MaxPrice > 0 && price > f.MaxPrice {
+		return price, fmt.Errorf("%s price %s > PRICE_FILTER maxPrice %s", pair.Symbol, formatFloat(price), formatFloat(f.MaxPrice))
+	}
+
+	return price, nil
+}
+
+// NormalizeQty 按LOT_SIZE(市价单优先使用MARKET_LOT_SIZE)把数量向下取整为stepSize的整数倍，并校验最小/最大数量
+func NormalizeQty(pair model.CurrencyPair, qty float64, isMarket bool) (float64, error) {
+	var (
+		f              = pair.Filters
+		filterName     = "LOT_SIZE"
+		minQty, maxQty = f.MinQty, f.MaxQty
+	)
+
+	if isMarket {
+		if f.MarketMinQty > 0 {
+			filterName, minQty = "MARKET_LOT_SIZE", f.MarketMinQty
+		}
+		if f.MarketMaxQty > 0 {
+			filterName, maxQty = "MARKET_LOT_SIZE", f.MarketMaxQty
+		}
+	}
+
+	qty = util.FloorToStep(qty, qtyStep(f, isMarket))
+
+	if minQty > 0 && qty < minQty {
+		return qty, fmt.Errorf("%s qty %s < %s minQty %s", pair.Symbol, formatFloat(qty), filterName, formatFloat(minQty))
+	}
+	if maxQty > 0 && qty > maxQty {
+		return qty, fmt.Errorf("%s qty %s > %s maxQty %s", pair.Symbol, formatFloat(qty), filterName, formatFloat(maxQty))
+	}
+
+	return qty, nil
+}
+
+// qtyStep 数量的stepSize，市价单优先使用MARKET_LOT_SIZE
+func qtyStep(f model.SymbolFilters, isMarket bool) float64 {
+	if isMarket && f.MarketStepSize > 0 { //现货MARKET_LOT_SIZE的stepSize可能为0，此时使用LOT_SIZE的stepSize
+		return f.MarketStepSize
+	}
+	return f.StepSize
+}
+
+// NormalizeExactOrderParams 把ExactQty/ExactPrice传入的精确数量和价格按交易对的Filters规整后转换为quantity/price，并用规整后的值校验交易规则
+// 参数:
+//   - params: 已合并opts的下单参数，exactQty/exactPrice会被删除，规整后的值以十进制字符串写入quantity/price
+//   - opts: 下单的可选参数，用于判断是否为只减仓订单
+//
+// 注意:
+//   - 在MergeOptionParams之后调用，保证发送的值就是校验的值，params中没有exactQty/exactPrice时不做任何处理
+//   - 不需要价格的订单类型(见IsPricedOrderType)忽略ExactPrice，不发送price
+//   - 数量按stepSize向下取整，价格按tickSize四舍五入，规则与NormalizeOrder一致，使用十进制运算没有浮点误差
+func NormalizeExactOrderParams(pair model.CurrencyPair, params *url.Values, side model.OrderSide, orderTy model.OrderType, opts ...model.OptionParameter) error {
+	exactQty, exactPrice := params.Get(model.Exact_Qty__Opt_Key), params.Get(model.Exact_Price__Opt_Key)
+	params.Del(model.Exact_Qty__Opt_Key)
+	params.Del(model.Exact_Price__Opt_Key)
+	if exactQty == "" && exactPrice == "" {
+		return nil
+	}
+
+	isMarket := !IsPricedOrderType(orderTy)
+
+	if exactQty != "" {
+		qty, err := model.NewDecimal(exactQty)
+		if err != nil {
+			return fmt.Errorf("%s exact qty: %w", pair.Symbol, err)
+		}
+		params.Set("quantity", qty.FloorToStep(model.NewDecimalFromFloat(qtyStep(pair.Filters, isMarket))).String())
+	}
+
+	if exactPrice != "" && !isMarket {
+		price, err := model.NewDecimal(exactPrice)
+		if err != nil {
+			return fmt.Errorf("%s exact price: %w", pair.Symbol, err)
+		}
+		params.Set("price", price.RoundToStep(model.NewDecimalFromFloat(pair.Filters.TickSize)).String())
+	}
+
+	_, _, err := NormalizeOrder(pair, cast.ToFloat64(params.Get("quantity")), cast.ToFloat64(params.Get("price")), side, orderTy, 0, opts...)
+	return err
+}
+
+// CheckNotional 校验名义价值(价格*数量)是否满足MIN_NOTIONAL/NOTIONAL，price为0时跳过
+func CheckNotional(pair model.CurrencyPair, qty, price float64, isMarket bool) error {
+	f := pair.Filters
+	if price <= 0 {
+		return nil
+	}
+
+	notional := qty * price
+	if f.MinNotional > 0 && (!isMarket || f.ApplyMinToMarket) && notional < f.MinNotional {
+		return fmt.Errorf("%s notional %s < minNotional %s", pair.Symbol, formatFloat(notional), formatFloat(f.MinNotional))
+	}
+	if f.MaxNotional > 0 && (!isMarket || f.ApplyMaxToMarket) && notional > f.MaxNotional {
+		return fmt.Errorf("%s notional %s > maxNotional %s", pair.Symbol, formatFloat(notional), formatFloat(f.MaxNotional))
+	}
+
+	return nil
+}
+
+// CheckPercentPrice 校验价格是否在参考价格的PERCENT_PRICE倍数范围内，refPrice为0时跳过
+func CheckPercentPrice(pair model.CurrencyPair, side model.OrderSide, price, refPrice float64) error {
+	f := pair.Filters
+	if refPrice <= 0 {
+		return nil
+	}
+
+	up, down := f.AskMultiplierUp, f.AskMultiplierDown
+	if AdaptOrderSideToString(side) == "BUY" {
+		up, down = f.BidMultiplierUp, f.BidMultiplierDown
+	}
+
+	if up > 0 && price > refPrice*up {
+		return fmt.Errorf("%s price %s > PERCENT_PRICE up limit %s", pair.Symbol, formatFloat(price), formatFloat(refPrice*up))
+	}
+	if down > 0 && price < refPrice*down {
+		return fmt.Errorf("%s price %s < PERCENT_PRICE down limit %s", pair.Symbol, formatFloat(price), formatFloat(refPrice*down))
+	}
+
+	return nil
+}
+
+// formatFloat 用于错误信息，保留8位小数并去除浮点误差
+func formatFloat(v float64) string {
+	return util.FloatToString(v, 8)
+}
@@ -0,0 +1,261 @@
+package common
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestUnmarshalSymbolFilters(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want model.SymbolFilters
+	}{
+		{
+			name: "spot",
+			data: `[
+				{"filterType":"PRICE_FILTER","minPrice":"0.01000000","maxPrice":"1000000.00000000","tickSize":"0.01000000"},
+				{"filterType":"LOT_SIZE","minQty":"0.00001000","maxQty":"9000.00000000","stepSize":"0.00001000"},
+				{"filterType":"MARKET_LOT_SIZE","minQty":"0.00000000","maxQty":"100.00000000","stepSize":"0.00000000"},
+				{"filterType":"NOTIONAL","minNotional":"5.00000000","applyMinToMarket":true,"maxNotional":"9000000.00000000","applyMaxToMarket":false},
+				{"filterType":"PERCENT_PRICE_BY_SIDE","bidMultiplierUp":"5","bidMultiplierDown":"0.2","askMultiplierUp":"4","askMultiplierDown":"0.3"}
+			]`,
+			want: model.SymbolFilters{
+				MinPrice: 0.01, MaxPrice: 1000000, TickSize: 0.01,
+				MinQty: 0.00001, MaxQty: 9000, StepSize: 0.00001,
+				MarketMaxQty: 100,
+				MinNotional:  5, MaxNotional: 9000000, ApplyMinToMarket: true,
+				BidMultiplierUp: 5, BidMultiplierDown: 0.2, AskMultiplierUp: 4, AskMultiplierDown: 0.3,
+			},
+		},
+		{
+			name: "spot legacy MIN_NOTIONAL",
+			data: `[{"filterType":"MIN_NOTIONAL","minNotional":"10.00000000","applyToMarket":false,"avgPriceMins":5}]`,
+			want: model.SymbolFilters{MinNotional: 10},
+		},
+		{
+			name: "usdt futures",
+			data: `[
+				{"filterType":"PRICE_FILTER","minPrice":"556.80","maxPrice":"4529764","tickSize":"0.10"},
+				{"filterType":"LOT_SIZE","minQty":"0.001","maxQty":"1000","stepSize":"0.001"},
+				{"filterType":"MARKET_LOT_SIZE","minQty":"0.001","maxQty":"120","stepSize":"0.001"},
+				{"filterType":"MIN_NOTIONAL","notional":"100"},
+				{"filterType":"PERCENT_PRICE","multiplierUp":"1.0500","multiplierDown":"0.9500","multiplierDecimal":"4"}
+			]`,
+			want: model.SymbolFilters{
+				MinPrice: 556.8, MaxPrice: 4529764, TickSize: 0.1,
+				MinQty: 0.001, MaxQty: 1000, StepSize: 0.001,
+				MarketMinQty: 0.001, MarketMaxQty: 120, MarketStepSize: 0.001,
+				MinNotional: 100, ApplyMinToMarket: true,
+				BidMultiplierUp: 1.05, BidMultiplierDown: 0.95, AskMultiplierUp: 1.05, AskMultiplierDown: 0.95,
+			},
+		},
+		{
+			name: "unknown filters are ignored",
+			data: `[{"filterType":"MAX_NUM_ORDERS","limit":200},{"filterType":"TRAILING_DELTA","minTrailingAboveDelta":10}]`,
+		},
+		{
+			name: "not an array",
+			data: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := UnmarshalSymbolFilters([]byte(tt.data)); got != tt.want {
+			t.Errorf("%s: UnmarshalSymbolFilters() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeOrder(t *testing.T) {
+	btc := model.CurrencyPair{Symbol: "BTCUSDT", Filters: model.SymbolFilters{
+		MinPrice: 0.01, MaxPrice: 1000000, TickSize: 0.01,
+		MinQty: 0.00001, MaxQty: 9000, StepSize: 0.00001,
+		MarketMaxQty: 100,
+		MinNotional:  5, ApplyMinToMarket: true,
+		BidMultiplierUp: 5, BidMultiplierDown: 0.2, AskMultiplierUp: 5, AskMultiplierDown: 0.2,
+	}}
+	pepe := model.CurrencyPair{Symbol: "PEPEUSDT", Filters: model.SymbolFilters{
+		TickSize: 0.00000001, MinQty: 1, StepSize: 1, MinNotional: 1,
+	}}
+	futures := model.CurrencyPair{Symbol: "BTCUSDT", Filters: model.SymbolFilters{
+		TickSize: 0.1, MinQty: 0.001, StepSize: 0.001,
+		MarketMinQty: 0.001, MarketMaxQty: 120, MarketStepSize: 0.001,
+		MinNotional: 100, ApplyMinToMarket: true,
+	}}
+
+	tests := []struct {
+		name      string
+		pair      model.CurrencyPair
+		qty       float64
+		price     float64
+		side      model.OrderSide
+		orderTy   model.OrderType
+		refPrice  float64
+		opts      []model.OptionParameter
+		wantQty   float64
+		wantPrice float64
+		wantErr   string
+	}{
+		{name: "limit rounds price and floors qty", pair: btc, qty: 0.0123456, price: 30000.276, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantQty: 0.01234, wantPrice: 30000.28},
+		{name: "limit maker is priced", pair: btc, qty: 0.001, price: 30000.004, side: model.Spot_Sell, orderTy: model.OrderType_LimitMaker, wantQty: 0.001, wantPrice: 30000},
+		{name: "market ignores price", pair: btc, qty: 0.001239, price: 1.234, side: model.Spot_Buy, orderTy: model.OrderType_Market, wantQty: 0.00123, wantPrice: 1.234},
+		{name: "small price ticks", pair: pepe, qty: 1000000.7, price: 0.000012345, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantQty: 1000000, wantPrice: 0.00001235},
+		{name: "min qty", pair: btc, qty: 0.000009, price: 30000, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantErr: "LOT_SIZE minQty"},
+		{name: "max qty", pair: btc, qty: 10000, price: 30000, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantErr: "LOT_SIZE maxQty"},
+		{name: "market max qty", pair: btc, qty: 101, side: model.Spot_Buy, orderTy: model.OrderType_Market, wantErr: "MARKET_LOT_SIZE maxQty"},
+		{name: "min price", pair: btc, qty: 1, price: 0.001, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantErr: "PRICE_FILTER minPrice"},
+		{name: "min notional", pair: btc, qty: 0.0001, price: 30000, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantErr: "minNotional"},
+		{name: "market notional uses refPrice", pair: btc, qty: 0.0001, side: model.Spot_Buy, orderTy: model.OrderType_Market, refPrice: 30000, wantErr: "minNotional"},
+		{name: "market notional skipped without refPrice", pair: btc, qty: 0.0001, side: model.Spot_Buy, orderTy: model.OrderType_Market, wantQty: 0.0001},
+		{name: "percent price up", pair: btc, qty: 0.001, price: 160000, side: model.Spot_Buy, orderTy: model.OrderType_Limit, refPrice: 30000, wantErr: "PERCENT_PRICE up"},
+		{name: "percent price down", pair: btc, qty: 1, price: 5000, side: model.Spot_Sell, orderTy: model.OrderType_Limit, refPrice: 30000, wantErr: "PERCENT_PRICE down"},
+		{name: "futures open below min notional", pair: futures, qty: 0.001, price: 30000, side: model.Futures_OpenBuy, orderTy: model.OrderType_Limit, wantErr: "minNotional"},
+		{name: "futures close is exempt", pair: futures, qty: 0.001, price: 30000, side: model.Futures_CloseBuy, orderTy: model.OrderType_Limit, wantQty: 0.001, wantPrice: 30000},
+		{name: "futures market close is exempt", pair: futures, qty: 0.001, side: model.Futures_CloseSell, orderTy: model.OrderType_Market, refPrice: 30000, wantQty: 0.001},
+		{name: "reduceOnly is exempt", pair: futures, qty: 0.001, price: 30000, side: model.Spot_Sell, orderTy: model.OrderType_Limit, opts: []model.OptionParameter{{Key: "reduceOnly", Value: "true"}}, wantQty: 0.001, wantPrice: 30000},
+		{name: "reduceOnly false is checked", pair: futures, qty: 0.001, price: 30000, side: model.Spot_Sell, orderTy: model.OrderType_Limit, opts: []model.OptionParameter{{Key: "reduceOnly", Value: "false"}}, wantErr: "minNotional"},
+		{name: "close still checks qty", pair: futures, qty: 0.0001, price: 30000, side: model.Futures_CloseBuy, orderTy: model.OrderType_Limit, wantErr: "LOT_SIZE minQty"},
+		{name: "no filters", pair: model.CurrencyPair{Symbol: "XUSDT"}, qty: 0.123456789, price: 1.23456789, side: model.Spot_Buy, orderTy: model.OrderType_Limit, wantQty: 0.123456789, wantPrice: 1.23456789},
+	}
+
+	for _, tt := range tests {
+		qty, price, err := NormalizeOrder(tt.pair, tt.qty, tt.price, tt.side, tt.orderTy, tt.refPrice, tt.opts...)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: NormalizeOrder() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: NormalizeOrder() error = %v", tt.name, err)
+			continue
+		}
+		if qty != tt.wantQty || price != tt.wantPrice {
+			t.Errorf("%s: NormalizeOrder() = %v, %v, want %v, %v", tt.name, qty, price, tt.wantQty, tt.wantPrice)
+		}
+	}
+}
+
+func TestCheckNotional(t *testing.T) {
+	pair := model.CurrencyPair{Symbol: "BTCUSDT", Filters: model.SymbolFilters{
+		MinNotional: 5, MaxNotional: 1000, ApplyMinToMarket: false, ApplyMaxToMarket: true,
+	}}
+
+	tests := []struct {
+		name     string
+		qty      float64
+		price    float64
+		isMarket bool
+		wantErr  string
+	}{
+		{name: "within range", qty: 1, price: 10},
+		{name: "exactly min", qty: 0.5, price: 10},
+		{name: "below min", qty: 0.4, price: 10, wantErr: "minNotional"},
+		{name: "above max", qty: 101, price: 10, wantErr: "maxNotional"},
+		{name: "market below min not applied", qty: 0.1, price: 10, isMarket: true},
+		{name: "market above max applied", qty: 101, price: 10, isMarket: true, wantErr: "maxNotional"},
+		{name: "zero price skipped", qty: 0.0001},
+	}
+
+	for _, tt := range tests {
+		err := CheckNotional(pair, tt.qty, tt.price, tt.isMarket)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%s: CheckNotional() error = %v", tt.name, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s: CheckNotional() error = %v, want %q", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestIsReduceOnlyOrder(t *testing.T) {
+	tests := []struct {
+		side model.OrderSide
+		opts []model.OptionParameter
+		want bool
+	}{
+		{side: model.Futures_CloseBuy, want: true},
+		{side: model.Futures_CloseSell, want: true},
+		{side: model.Futures_OpenBuy},
+		{side: model.Spot_Sell},
+		{side: model.Futures_OpenSell, opts: []model.OptionParameter{{Key: "reduceOnly", Value: "true"}}, want: true},
+		{side: model.Futures_OpenSell, opts: []model.OptionParameter{{Key: "reduceOnly", Value: "false"}}},
+	}
+
+	for _, tt := range tests {
+		if got := IsReduceOnlyOrder(tt.side, tt.opts...); got != tt.want {
+			t.Errorf("IsReduceOnlyOrder(%s, %v) = %v, want %v", tt.side, tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestNormalizeExactOrderParams(t *testing.T) {
+	pepe := model.CurrencyPair{Symbol: "PEPEUSDT", Filters: model.SymbolFilters{
+		TickSize: 0.00000001, MinQty: 1, StepSize: 1, MinNotional: 5, ApplyMinToMarket: true,
+	}}
+
+	tests := []struct {
+		name    string
+		orderTy model.OrderType
+		params  url.Values
+		want    url.Values
+		wantErr string
+	}{
+		{
+			name:    "no exact params",
+			orderTy: model.OrderType_Limit,
+			params:  url.Values{"quantity": {"1000000"}, "price": {"0.00001234"}},
+			want:    url.Values{"quantity": {"1000000"}, "price": {"0.00001234"}},
+		},
+		{
+			name:    "exact qty and price are normalized",
+			orderTy: model.OrderType_Limit,
+			params:  url.Values{"quantity": {"1000000"}, "price": {"0.00001"}, "exactQty": {"1000000.7"}, "exactPrice": {"0.000012345"}},
+			want:    url.Values{"quantity": {"1000000"}, "price": {"0.00001235"}},
+		},
+		{
+			name:    "exact price dropped for market orders",
+			orderTy: model.OrderType_Market,
+			params:  url.Values{"quantity": {"1000000"}, "exactQty": {"1000000.7"}, "exactPrice": {"0.000012345"}},
+			want:    url.Values{"quantity": {"1000000"}},
+		},
+		{
+			name:    "exact price dropped for stop market orders",
+			orderTy: model.OrderType_StopMarket,
+			params:  url.Values{"quantity": {"1000000"}, "exactPrice": {"0.000012345"}},
+			want:    url.Values{"quantity": {"1000000"}},
+		},
+		{
+			name:    "exact values below min notional",
+			orderTy: model.OrderType_Limit,
+			params:  url.Values{"quantity": {"1000000"}, "price": {"0.00001"}, "exactQty": {"100000"}, "exactPrice": {"0.00001"}},
+			wantErr: "minNotional",
+		},
+		{
+			name:    "invalid exact qty",
+			orderTy: model.OrderType_Limit,
+			params:  url.Values{"quantity": {"1000000"}, "exactQty": {"abc"}},
+			wantErr: "exact qty",
+		},
+	}
+
+	for _, tt := range tests {
+		err := NormalizeExactOrderParams(pepe, &tt.params, model.Spot_Buy, tt.orderTy)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: NormalizeExactOrderParams() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: NormalizeExactOrderParams() error = %v", tt.name, err)
+			continue
+		}
+		if got := tt.params.Encode(); got != tt.want.Encode() {
+			t.Errorf("%s: params = %s, want %s", tt.name, got, tt.want.Encode())
+		}
+	}
+}
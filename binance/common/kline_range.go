@@ -0,0 +1,94 @@
+package common
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"strconv"
+)
+
+// KlineIntervalMillis 币安K线周期(如1m、4h、1d、1w)对应的毫秒数
+// 参数:
+//   - interval: 币安K线周期，见AdaptKlinePeriodToSymbol
+//
+// 返回值:
+//   - int64: 周期毫秒数，月线(1M)等不固定周期或无法识别时返回0
+func KlineIntervalMillis(interval string) int64 {
+	if len(interval) < 2 {
+		return 0
+	}
+
+	n, err := strconv.ParseInt(interval[:len(interval)-1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	switch interval[len(interval)-1] {
+	case 'm':
+		return n * 60 * 1000
+	case 'h':
+		return n * 60 * 60 * 1000
+	case 'd':
+		return n * 24 * 60 * 60 * 1000
+	case 'w':
+		return n * 7 * 24 * 60 * 60 * 1000
+	}
+	return 0
+}
+
+// StreamKlineRange 按时间翻页获取[start, end]区间内的K线，去重后分页回调，并检测缺失的K线
+// 参数:
+//   - start: 开始时间(毫秒)，包含
+//   - end: 结束时间(毫秒)，包含
+//   - interval: K线周期毫秒数，为0时不检测缺失
+//   - limit: 每页条数，返回条数小于limit时认为数据已取完
+//   - fetch: 请求开盘时间在[start, end]内的一页K线(按时间升序)
+//   - handler: 每页去重后的K线回调，返回错误时停止翻页
+//
+// 返回值:
+//   - []model.KlineGap: 相邻两根K线之间缺失的区间
+//   - error: fetch或handler返回的错误
+//
+// 注意:
+//   - 只检测已返回的K线之间的缺失，start之后尚未上市、end之后尚未生成的K线不视为缺失
+func StreamKlineRange(start, end, interval int64, limit int, fetch func(start, end int64) ([]model.Kline, error), handler func([]model.Kline) error) ([]model.KlineGap, error) {
+	var (
+		gaps   []model.KlineGap
+		lastTs int64 = -1
+		cursor       = start
+	)
+
+	for cursor <= end {
+		page, err := fetch(cursor, end)
+		if err != nil {
+			return gaps, err
+		}
+
+		klines := make([]model.Kline, 0, len(page))
+		for _, k := range page {
+			//翻页时的重叠数据和区间外的数据
+			if k.Timestamp <= lastTs || k.Timestamp < start || k.Timestamp > end {
+				continue
+			}
+
+			if interval > 0 && lastTs >= 0 && k.Timestamp-lastTs > interval {
+				gaps = append(gaps, model.KlineGap{Start: lastTs + interval, End: k.Timestamp - interval})
+			}
+
+			lastTs = k.Timestamp
+			klines = append(klines, k)
+		}
+
+		if len(klines) > 0 {
+			if err = handler(klines); err != nil {
+				return gaps, err
+			}
+		}
+
+		if len(page) < limit || lastTs < cursor {
+			break
+		}
+
+		cursor = lastTs + 1
+	}
+
+	return gaps, nil
+}
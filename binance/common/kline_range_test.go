@@ -0,0 +1,147 @@
+package common
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/model"
+	"reflect"
+	"testing"
+)
+
+func TestKlineIntervalMillis(t *testing.T) {
+	tests := []struct {
+		interval string
+		want     int64
+	}{
+		{"1m", 60 * 1000},
+		{"15m", 15 * 60 * 1000},
+		{"4h", 4 * 60 * 60 * 1000},
+		{"1d", 24 * 60 * 60 * 1000},
+		{"1w", 7 * 24 * 60 * 60 * 1000},
+		{"1M", 0},
+		{"m", 0},
+		{"xm", 0},
+	}
+
+	for _, tt := range tests {
+		if got := KlineIntervalMillis(tt.interval); got != tt.want {
+			t.Errorf("KlineIntervalMillis(%q) = %d, want %d", tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestStreamKlineRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []int64 //交易所上的K线开盘时间
+		start    int64
+		end      int64
+		limit    int
+		overlap  int //每页额外返回的上一页末尾K线数量，模拟翻页边界的重复数据
+		wantTs   []int64
+		wantGaps []model.KlineGap
+	}{
+		{
+			name:   "single page",
+			data:   []int64{0, 10, 20},
+			start:  0,
+			end:    20,
+			limit:  10,
+			wantTs: []int64{0, 10, 20},
+		},
+		{
+			name:   "page boundary",
+			data:   []int64{0, 10, 20, 30, 40},
+			start:  0,
+			end:    40,
+			limit:  2,
+			wantTs: []int64{0, 10, 20, 30, 40},
+		},
+		{
+			name:    "overlapping pages are deduplicated",
+			data:    []int64{0, 10, 20, 30, 40, 50},
+			start:   0,
+			end:     50,
+			limit:   3,
+			overlap: 1,
+			wantTs:  []int64{0, 10, 20, 30, 40, 50},
+		},
+		{
+			name:   "out of range klines are dropped",
+			data:   []int64{0, 10, 20, 30, 40},
+			start:  10,
+			end:    30,
+			limit:  2,
+			wantTs: []int64{10, 20, 30},
+		},
+		{
+			name:     "missing klines across a page boundary",
+			data:     []int64{0, 10, 40, 50},
+			start:    0,
+			end:      50,
+			limit:    2,
+			overlap:  1,
+			wantTs:   []int64{0, 10, 40, 50},
+			wantGaps: []model.KlineGap{{Start: 20, End: 30}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := func(start, end int64) ([]model.Kline, error) {
+				var page []model.Kline
+				for i, ts := range tt.data {
+					if ts < start || ts > end {
+						continue
+					}
+					for _, prev := range tt.data[max(0, i-tt.overlap):i] {
+						if prev >= tt.start {
+							page = append(page, model.Kline{Timestamp: prev})
+						}
+					}
+					for _, ts := range tt.data[i:] {
+						if ts > end || len(page) >= tt.limit {
+							break
+						}
+						page = append(page, model.Kline{Timestamp: ts})
+					}
+					break
+				}
+				return page, nil
+			}
+
+			var gotTs []int64
+			gaps, err := StreamKlineRange(tt.start, tt.end, 10, tt.limit, fetch, func(klines []model.Kline) error {
+				for _, k := range klines {
+					gotTs = append(gotTs, k.Timestamp)
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("StreamKlineRange() error = %v", err)
+			}
+			if !reflect.DeepEqual(gotTs, tt.wantTs) {
+				t.Errorf("klines = %v, want %v", gotTs, tt.wantTs)
+			}
+			if !reflect.DeepEqual(gaps, tt.wantGaps) {
+				t.Errorf("gaps = %v, want %v", gaps, tt.wantGaps)
+			}
+		})
+	}
+}
+
+func TestStreamKlineRangeHandlerError(t *testing.T) {
+	errStop := errors.New("stop")
+	calls := 0
+	fetch := func(start, end int64) ([]model.Kline, error) {
+		calls++
+		return []model.Kline{{Timestamp: start}, {Timestamp: start + 10}}, nil
+	}
+
+	_, err := StreamKlineRange(0, 100, 10, 2, fetch, func([]model.Kline) error { return errStop })
+	if !errors.Is(err, errStop) {
+		t.Fatalf("StreamKlineRange() error = %v, want %v", err, errStop)
+	}
+	if calls != 1 {
+		t.Errorf("fetch called %d times, want 1", calls)
+	}
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"net/url"
+	"time"
+)
+
+// PageByTime 按时间窗口切分[startTime, endTime]，并在每个窗口内按时间翻页
+// 参数:
+//   - startTime: 开始时间(毫秒)
+//   - endTime: 结束时间(毫秒)，包含
+//   - window: 接口单次查询允许的最大时间跨度(毫秒)
+//   - limit: 每页条数，返回条数小于limit时认为窗口内数据已取完
+//   - fetch: 请求[start, end]区间的一页数据，返回本页条数和最后一条数据的时间(按时间升序)
+//
+// 返回值:
+//   - error: fetch返回的错误
+//
+// 注意:
+//   - 同一毫秒可能有多条数据，下一页从上一页最后一条的时间开始查询，调用方需要自行去重
+func PageByTime(startTime, endTime, window int64, limit int, fetch func(start, end int64) (n int, lastTime int64, err error)) error {
+	for windowStart := startTime; windowStart <= endTime; windowStart += window {
+		windowEnd := min(windowStart+window-1, endTime)
+		pageStart := windowStart
+
+		for {
+			n, lastTime, err := fetch(pageStart, windowEnd)
+			if err != nil {
+				return err
+			}
+
+			if n < limit {
+				break
+			}
+
+			if lastTime <= pageStart {
+				lastTime = pageStart + 1
+			}
+			if lastTime > windowEnd {
+				break
+			}
+			pageStart = lastTime
+		}
+	}
+	return nil
+}
+
+// PageById 从fromId开始按ID递增翻页，直到返回条数小于limit
+// 参数:
+//   - fromId: 起始ID，包含
+//   - limit: 每页条数
+//   - fetch: 请求ID大于等于fromId的一页数据，返回本页条数和最后一条数据的ID(按ID升序)
+//
+// 返回值:
+//   - error: fetch返回的错误
+func PageById(fromId int64, limit int, fetch func(fromId int64) (n int, lastId int64, err error)) error {
+	for {
+		n, lastId, err := fetch(fromId)
+		if err != nil {
+			return err
+		}
+		if n < limit || lastId < fromId {
+			return nil
+		}
+		fromId = lastId + 1
+	}
+}
+
+// PageFills 按fromId或startTime自动翻页获取成交明细，并按成交ID去重
+// 参数:
+//   - params: 请求参数，需包含limit，翻页时会改写其中的fromId、startTime、endTime
+//   - window: 成交明细接口单次查询允许的最大时间跨度(毫秒)
+//   - fetch: 按params请求一页成交明细，返回本页成交和原始响应数据
+//
+// 返回值:
+//   - []model.Fill: 去重后的成交明细
+//   - []byte: 最后一次请求的原始响应数据
+//   - error: fetch返回的错误
+//
+// 注意:
+//   - 传入fromId时用PageById翻页，传入startTime时用PageByTime翻页，endTime默认为当前时间
+//   - 都不传时只请求一次
+func PageFills(params *url.Values, window int64, fetch func(params *url.Values) ([]model.Fill, []byte, error)) (fills []model.Fill, responseBody []byte, err error) {
+	var (
+		limit = cast.ToInt(params.Get("limit"))
+		seen  = make(map[string]struct{}, limit)
+	)
+
+	//返回本页成交，已去重的成交追加到fills
+	fetchPage := func() (page []model.Fill, err error) {
+		page, responseBody, err = fetch(params)
+		if err != nil {
+			return nil, err
+		}
+		for _, fill := range page {
+			if _, ok := seen[fill.Id]; ok {
+				continue
+			}
+			seen[fill.Id] = struct{}{}
+			fills = append(fills, fill)
+		}
+		return page, nil
+	}
+
+	switch {
+	case params.Get("fromId") != "":
+		err = PageById(cast.ToInt64(params.Get("fromId")), limit, func(fromId int64) (int, int64, error) {
+			params.Set("fromId", fmt.Sprint(fromId))
+			page, err := fetchPage()
+			if err != nil || len(page) == 0 {
+				return 0, 0, err
+			}
+			return len(page), cast.ToInt64(page[len(page)-1].Id), nil
+		})
+	case params.Get("startTime") != "":
+		endTime := cast.ToInt64(params.Get("endTime"))
+		if endTime == 0 {
+			endTime = time.Now().UnixMilli()
+		}
+		err = PageByTime(cast.ToInt64(params.Get("startTime")), endTime, window, limit, func(start, end int64) (int, int64, error) {
+			params.Set("startTime", fmt.Sprint(start))
+			params.Set("endTime", fmt.Sprint(end))
+			page, err := fetchPage()
+			if err != nil || len(page) == 0 {
+				return 0, 0, err
+			}
+			return len(page), page[len(page)-1].Time, nil
+		})
+	default:
+		_, err = fetchPage()
+	}
+
+	return fills, responseBody, err
+}
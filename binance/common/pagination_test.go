@@ -0,0 +1,272 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestPageById(t *testing.T) {
+	tests := []struct {
+		name        string
+		total       int64 //数据ID为1..total
+		fromId      int64
+		limit       int
+		wantFromIds []int64
+	}{
+		{name: "single short page", total: 5, fromId: 1, limit: 10, wantFromIds: []int64{1}},
+		{name: "advances past last id", total: 25, fromId: 1, limit: 10, wantFromIds: []int64{1, 11, 21}},
+		{name: "stops on empty page", total: 20, fromId: 1, limit: 10, wantFromIds: []int64{1, 11, 21}},
+		{name: "starts from fromId", total: 25, fromId: 18, limit: 5, wantFromIds: []int64{18, 23}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fromIds []int64
+			err := PageById(tt.fromId, tt.limit, func(fromId int64) (int, int64, error) {
+				fromIds = append(fromIds, fromId)
+				lastId := min(fromId+int64(tt.limit)-1, tt.total)
+				if lastId < fromId {
+					return 0, 0, nil
+				}
+				return int(lastId - fromId + 1), lastId, nil
+			})
+			if err != nil {
+				t.Fatalf("PageById() error = %v", err)
+			}
+			if !reflect.DeepEqual(fromIds, tt.wantFromIds) {
+				t.Errorf("PageById() fromIds = %v, want %v", fromIds, tt.wantFromIds)
+			}
+		})
+	}
+
+	wantErr := errors.New("network down")
+	if err := PageById(1, 10, func(int64) (int, int64, error) { return 0, 0, wantErr }); err != wantErr {
+		t.Errorf("PageById() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestPageByTime(t *testing.T) {
+	type call struct{ start, end int64 }
+
+	tests := []struct {
+		name      string
+		startTime int64
+		endTime   int64
+		window    int64
+		limit     int
+		pages     map[int64][]int64 //pageStart -> 本页数据的时间
+		wantCalls []call
+	}{
+		{
+			name:      "splits into windows",
+			startTime: 0,
+			endTime:   250,
+			window:    100,
+			limit:     10,
+			wantCalls: []call{{0, 99}, {100, 199}, {200, 250}},
+		},
+		{
+			name:      "pages inside a window from last time",
+			startTime: 0,
+			endTime:   99,
+			window:    100,
+			limit:     3,
+			pages: map[int64][]int64{
+				0:  {10, 20, 30},
+				30: {30, 40, 50},
+				50: {60},
+			},
+			wantCalls: []call{{0, 99}, {30, 99}, {50, 99}},
+		},
+		{
+			name:      "full page in one millisecond advances by one",
+			startTime: 0,
+			endTime:   99,
+			window:    100,
+			limit:     3,
+			pages: map[int64][]int64{
+				0: {5, 5, 5},
+				5: {5, 5, 5},
+				6: {7},
+			},
+			wantCalls: []call{{0, 99}, {5, 99}, {6, 99}},
+		},
+		{
+			name:      "stops when last time reaches window end",
+			startTime: 0,
+			endTime:   199,
+			window:    100,
+			limit:     2,
+			pages: map[int64][]int64{
+				0:   {50, 99},
+				99:  {99, 99},
+				100: {150},
+			},
+			wantCalls: []call{{0, 99}, {99, 99}, {100, 199}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []call
+			err := PageByTime(tt.startTime, tt.endTime, tt.window, tt.limit, func(start, end int64) (int, int64, error) {
+				calls = append(calls, call{start, end})
+				if len(calls) > 10 {
+					t.Fatalf("PageByTime() did not terminate, calls = %v", calls)
+				}
+				page := tt.pages[start]
+				if len(page) == 0 {
+					return 0, 0, nil
+				}
+				return len(page), page[len(page)-1], nil
+			})
+			if err != nil {
+				t.Fatalf("PageByTime() error = %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("PageByTime() calls = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+// fillStore 模拟成交明细接口，成交按时间和ID升序排列
+type fillStore struct {
+	fills    []model.Fill
+	requests []string
+	failAt   int
+}
+
+func (s *fillStore) fetch(params *url.Values) ([]model.Fill, []byte, error) {
+	s.requests = append(s.requests, params.Encode())
+	if len(s.requests) == s.failAt {
+		return nil, []byte(`{"code":-1000}`), errors.New("network down")
+	}
+
+	var (
+		limit  = cast.ToInt(params.Get("limit"))
+		fromId = params.Get("fromId")
+		start  = cast.ToInt64(params.Get("startTime"))
+		end    = cast.ToInt64(params.Get("endTime"))
+		page   []model.Fill
+	)
+	for _, fill := range s.fills {
+		if len(page) == limit {
+			break
+		}
+		if fromId != "" && cast.ToInt64(fill.Id) < cast.ToInt64(fromId) {
+			continue
+		}
+		if params.Has("startTime") && (fill.Time < start || fill.Time > end) {
+			continue
+		}
+		page = append(page, fill)
+	}
+	return page, []byte(fmt.Sprintf(`[%d]`, len(page))), nil
+}
+
+func newFillStore(times ...int64) *fillStore {
+	s := &fillStore{}
+	for i, tm := range times {
+		s.fills = append(s.fills, model.Fill{Id: fmt.Sprint(i + 1), Time: tm})
+	}
+	return s
+}
+
+func fillIds(fills []model.Fill) []string {
+	ids := make([]string, 0, len(fills))
+	for _, fill := range fills {
+		ids = append(ids, fill.Id)
+	}
+	return ids
+}
+
+func TestPageFills(t *testing.T) {
+	const day = int64(24 * 60 * 60 * 1000)
+
+	tests := []struct {
+		name         string
+		store        *fillStore
+		params       url.Values
+		window       int64
+		wantIds      []string
+		wantRequests []string
+		wantErr      bool
+	}{
+		{
+			name:         "no paging params requests once",
+			store:        newFillStore(1, 2, 3, 4),
+			params:       url.Values{"limit": {"2"}},
+			window:       day,
+			wantIds:      []string{"1", "2"},
+			wantRequests: []string{"limit=2"},
+		},
+		{
+			name:         "pages by fromId",
+			store:        newFillStore(1, 2, 3, 4, 5),
+			params:       url.Values{"limit": {"2"}, "fromId": {"1"}},
+			window:       day,
+			wantIds:      []string{"1", "2", "3", "4", "5"},
+			wantRequests: []string{"fromId=1&limit=2", "fromId=3&limit=2", "fromId=5&limit=2"},
+		},
+		{
+			name:    "dedupes fills sharing the page boundary time",
+			store:   newFillStore(10, 20, 30, 30, 30, 40),
+			params:  url.Values{"limit": {"3"}, "startTime": {"0"}, "endTime": {"99"}},
+			window:  day,
+			wantIds: []string{"1", "2", "3", "4", "5", "6"},
+			wantRequests: []string{
+				"endTime=99&limit=3&startTime=0",
+				"endTime=99&limit=3&startTime=30",
+				"endTime=99&limit=3&startTime=31",
+			},
+		},
+		{
+			name:    "splits startTime range into windows",
+			store:   newFillStore(10, day+10, 2*day+10),
+			params:  url.Values{"limit": {"10"}, "startTime": {"0"}, "endTime": {fmt.Sprint(2*day + 100)}},
+			window:  day,
+			wantIds: []string{"1", "2", "3"},
+			wantRequests: []string{
+				fmt.Sprintf("endTime=%d&limit=10&startTime=0", day-1),
+				fmt.Sprintf("endTime=%d&limit=10&startTime=%d", 2*day-1, day),
+				fmt.Sprintf("endTime=%d&limit=10&startTime=%d", 2*day+100, 2*day),
+			},
+		},
+		{
+			name:    "returns fetched fills on error",
+			store:   &fillStore{fills: newFillStore(1, 2, 3, 4, 5).fills, failAt: 2},
+			params:  url.Values{"limit": {"2"}, "fromId": {"1"}},
+			window:  day,
+			wantIds: []string{"1", "2"},
+			wantRequests: []string{
+				"fromId=1&limit=2",
+				"fromId=3&limit=2",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fills, responseBody, err := PageFills(&tt.params, tt.window, tt.store.fetch)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PageFills() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ids := fillIds(fills); !reflect.DeepEqual(ids, tt.wantIds) {
+				t.Errorf("PageFills() ids = %v, want %v", ids, tt.wantIds)
+			}
+			if !reflect.DeepEqual(tt.store.requests, tt.wantRequests) {
+				t.Errorf("PageFills() requests = %v, want %v", tt.store.requests, tt.wantRequests)
+			}
+			if len(responseBody) == 0 {
+				t.Errorf("PageFills() responseBody is empty")
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultSymbolRegistryTTL 交易对信息的默认有效期，过期后在下一次读取时后台刷新
+const DefaultSymbolRegistryTTL = time.Hour
+
+// SymbolChange 交易对信息刷新后的变化
+type SymbolChange struct {
+	Added   []model.CurrencyPair //新上线的交易对
+	Removed []model.CurrencyPair //下线的交易对
+	Updated []model.CurrencyPair //精度、交易规则等发生变化的交易对，为变化后的值
+}
+
+// SymbolRegistry 线程安全的交易对信息注册表，同一市场的REST和WebSocket共用一个实例
+// 键为BaseSymbol+QuoteSymbol+ContractAlias，与GetExchangeInfo返回的map一致，同时按symbol建立索引
+type SymbolRegistry struct {
+	loader func() (map[string]model.CurrencyPair, []byte, error) //从交易所下载交易对信息，不访问注册表
+
+	mu        sync.RWMutex
+	ttl       time.Duration
+	pairs     map[string]model.CurrencyPair //刷新时整体替换，不在原map上修改，可以直接返回给调用方
+	bySymbol  map[string]model.CurrencyPair
+	updatedAt time.Time
+	listeners []func(SymbolChange)
+
+	loadMu     sync.Mutex  //保证同一时间只有一个下载请求
+	refreshing atomic.Bool //是否有正在进行的后台刷新
+}
+
+// NewSymbolRegistry 创建交易对信息注册表
+// 参数:
+//   - loader: 下载并解析交易所信息的方法，如现货的/api/v3/exchangeInfo
+//   - ttl: 有效期，小于等于0时不自动刷新
+//
+// 注意:
+//   - 创建时不会下载，第一次读取(Load/BySymbol)时才会加载
+func NewSymbolRegistry(loader func() (map[string]model.CurrencyPair, []byte, error), ttl time.Duration) *SymbolRegistry {
+	return &SymbolRegistry{loader: loader, ttl: ttl}
+}
+
+// SetTTL 设置有效期，小于等于0时不自动刷新
+func (r *SymbolRegistry) SetTTL(ttl time.Duration) {
+	r.mu.Lock()
+	r.ttl = ttl
+	r.mu.Unlock()
+}
+
+// OnChange 注册交易对信息变化的回调，在刷新后且有变化时调用，首次加载不回调
+// 使用示例:
+//
+//	spot.SymbolRegistry().OnChange(func(change common.SymbolChange) {
+//	  for _, pair := range change.Removed {
+//	    log.Printf("%s delisted", pair.Symbol)
+//	  }
+//	})
+func (r *SymbolRegistry) OnChange(fn func(SymbolChange)) {
+	r.mu.Lock()
+	r.listeners = append(r.listeners, fn)
+	r.mu.Unlock()
+}
+
+// Refresh 立即从交易所重新下载交易对信息
+// 返回值:
+//   - map[string]model.CurrencyPair: 最新的交易对信息，调用方不要修改
+//   - []byte: 原始响应数据
+//   - error: 下载或解析失败时的错误信息，此时保留原有数据
+func (r *SymbolRegistry) Refresh() (map[string]model.CurrencyPair, []byte, error) {
+	r.loadMu.Lock()
+	defer r.loadMu.Unlock()
+	return r.refresh()
+}
+
+// Load 返回交易对信息，未加载时同步下载，已过期时返回当前数据并在后台刷新
+func (r *SymbolRegistry) Load() (map[string]model.CurrencyPair, error) {
+	r.mu.RLock()
+	pairs, stale := r.pairs, r.isStale()
+	r.mu.RUnlock()
+
+	if pairs == nil {
+		r.loadMu.Lock()
+		defer r.loadMu.Unlock()
+
+		r.mu.RLock()
+		pairs = r.pairs
+		r.mu.RUnlock()
+		if pairs != nil { //其他goroutine已加载
+			return pairs, nil
+		}
+
+		pairs, _, err := r.refresh()
+		return pairs, err
+	}
+
+	if stale && r.refreshing.CompareAndSwap(false, true) {
+		go func() {
+			defer r.refreshing.Store(false)
+			if _, _, err := r.Refresh(); err != nil {
+				logger.Warnf("[SymbolRegistry] refresh exchange info error: %s", err.Error())
+			}
+		}()
+	}
+
+	return pairs, nil
+}
+
+// Pairs 返回当前的交易对信息，不会触发下载，未加载时返回nil
+func (r *SymbolRegistry) Pairs() map[string]model.CurrencyPair {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.pairs
+}
+
+// BySymbol 按交易所symbol查找交易对，如BTCUSDT，用于WebSocket推送等只有symbol的场景
+func (r *SymbolRegistry) BySymbol(symbol string) (model.CurrencyPair, bool) {
+	if _, err := r.Load(); err != nil {
+		return model.CurrencyPair{}, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	pair, ok := r.bySymbol[symbol]
+	return pair, ok
+}
+
+// refresh 下载并替换交易对信息，调用方需持有loadMu
+func (r *SymbolRegistry) refresh() (map[string]model.CurrencyPair, []byte, error) {
+	pairs, body, err := r.loader()
+	if err != nil {
+		return nil, body, err
+	}
+
+	bySymbol := make(map[string]model.CurrencyPair, len(pairs))
+	for _, pair := range pairs {
+		bySymbol[pair.Symbol] = pair
+	}
+
+	r.mu.Lock()
+	old := r.pairs
+	r.pairs = pairs
+	r.bySymbol = bySymbol
+	r.updatedAt = time.Now()
+	listeners := r.listeners
+	r.mu.Unlock()
+
+	if old != nil && len(listeners) > 0 {
+		if change, changed := diffSymbols(old, pairs); changed {
+			for _, fn := range listeners {
+				fn(change)
+			}
+		}
+	}
+
+	return pairs, body, nil
+}
+
+// isStale 是否超过有效期，调用方需持有mu
+func (r *SymbolRegistry) isStale() bool {
+	return r.ttl > 0 && r.pairs != nil && time.Since(r.updatedAt) > r.ttl
+}
+
+func diffSymbols(old, pairs map[string]model.CurrencyPair) (change SymbolChange, changed bool) {
+	for k, pair := range pairs {
+		oldPair, ok := old[k]
+		if !ok {
+			change.Added = append(change.Added, pair)
+		} else if oldPair != pair {
+			change.Updated = append(change.Updated, pair)
+		}
+	}
+
+	for k, pair := range old {
+		if _, ok := pairs[k]; !ok {
+			change.Removed = append(change.Removed, pair)
+		}
+	}
+
+	changed = len(change.Added) > 0 || len(change.Removed) > 0 || len(change.Updated) > 0
+	return
+}
@@ -0,0 +1,215 @@
+package common
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/model"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubLoader 按顺序返回预设的交易对信息，超出后重复最后一个，并记录调用次数
+type stubLoader struct {
+	mu      sync.Mutex
+	results []stubLoadResult
+	calls   atomic.Int32
+	delay   time.Duration
+}
+
+type stubLoadResult struct {
+	pairs map[string]model.CurrencyPair
+	err   error
+}
+
+func (l *stubLoader) load() (map[string]model.CurrencyPair, []byte, error) {
+	n := int(l.calls.Add(1))
+	time.Sleep(l.delay)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	r := l.results[min(n, len(l.results))-1]
+	return r.pairs, []byte("body"), r.err
+}
+
+func testPairs(pairs ...model.CurrencyPair) map[string]model.CurrencyPair {
+	m := make(map[string]model.CurrencyPair, len(pairs))
+	for _, p := range pairs {
+		m[p.BaseSymbol+p.QuoteSymbol+p.ContractAlias] = p
+	}
+	return m
+}
+
+var (
+	btcUsdt = model.CurrencyPair{Symbol: "BTCUSDT", BaseSymbol: "BTC", QuoteSymbol: "USDT", PricePrecision: 2}
+	ethUsdt = model.CurrencyPair{Symbol: "ETHUSDT", BaseSymbol: "ETH", QuoteSymbol: "USDT", PricePrecision: 2}
+	solUsdt = model.CurrencyPair{Symbol: "SOLUSDT", BaseSymbol: "SOL", QuoteSymbol: "USDT", PricePrecision: 3}
+)
+
+func TestSymbolRegistryLazyLoad(t *testing.T) {
+	loader := &stubLoader{results: []stubLoadResult{{pairs: testPairs(btcUsdt, ethUsdt)}}}
+	r := NewSymbolRegistry(loader.load, time.Hour)
+
+	if r.Pairs() != nil || loader.calls.Load() != 0 {
+		t.Fatalf("registry loaded before first use")
+	}
+
+	pair, ok := r.BySymbol("ETHUSDT")
+	if !ok || pair != ethUsdt {
+		t.Errorf("BySymbol(ETHUSDT) = %+v, %v", pair, ok)
+	}
+	if _, ok = r.BySymbol("XRPUSDT"); ok {
+		t.Errorf("BySymbol(XRPUSDT) found an unknown symbol")
+	}
+	if pairs, err := r.Load(); err != nil || len(pairs) != 2 {
+		t.Errorf("Load() = %v, %v", pairs, err)
+	}
+	if n := loader.calls.Load(); n != 1 {
+		t.Errorf("loader called %d times, want 1", n)
+	}
+}
+
+func TestSymbolRegistryConcurrentLoad(t *testing.T) {
+	loader := &stubLoader{results: []stubLoadResult{{pairs: testPairs(btcUsdt)}}, delay: 20 * time.Millisecond}
+	r := NewSymbolRegistry(loader.load, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, ok := r.BySymbol("BTCUSDT"); !ok {
+				t.Errorf("BySymbol(BTCUSDT) not found")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := loader.calls.Load(); n != 1 {
+		t.Errorf("loader called %d times, want 1", n)
+	}
+}
+
+func TestSymbolRegistryInitialLoadError(t *testing.T) {
+	errDown := errors.New("network down")
+	loader := &stubLoader{results: []stubLoadResult{{err: errDown}, {pairs: testPairs(btcUsdt)}}}
+	r := NewSymbolRegistry(loader.load, time.Hour)
+
+	if _, err := r.Load(); !errors.Is(err, errDown) {
+		t.Fatalf("Load() error = %v, want %v", err, errDown)
+	}
+	if _, ok := r.BySymbol("BTCUSDT"); !ok {
+		t.Errorf("BySymbol(BTCUSDT) did not retry the failed load")
+	}
+}
+
+func TestSymbolRegistryTTLRefresh(t *testing.T) {
+	loader := &stubLoader{results: []stubLoadResult{{pairs: testPairs(btcUsdt)}, {pairs: testPairs(btcUsdt, ethUsdt)}}}
+	r := NewSymbolRegistry(loader.load, 10*time.Millisecond)
+
+	first, err := r.Load()
+	if err != nil || len(first) != 1 {
+		t.Fatalf("Load() = %v, %v", first, err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	stale, err := r.Load() //过期后先返回旧数据，在后台刷新
+	if err != nil || len(stale) != 1 {
+		t.Fatalf("Load() after ttl = %v, %v, want the previous snapshot", stale, err)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for len(r.Pairs()) != 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("background refresh did not replace the snapshot, loader calls = %d", loader.calls.Load())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if _, ok := r.BySymbol("ETHUSDT"); !ok {
+		t.Errorf("BySymbol(ETHUSDT) not found after refresh")
+	}
+}
+
+func TestSymbolRegistryNoTTL(t *testing.T) {
+	loader := &stubLoader{results: []stubLoadResult{{pairs: testPairs(btcUsdt)}}}
+	r := NewSymbolRegistry(loader.load, 0)
+
+	_, _ = r.Load()
+	time.Sleep(5 * time.Millisecond)
+	_, _ = r.Load()
+	time.Sleep(5 * time.Millisecond)
+
+	if n := loader.calls.Load(); n != 1 {
+		t.Errorf("loader called %d times without ttl, want 1", n)
+	}
+}
+
+func TestSymbolRegistryRefreshErrorKeepsSnapshot(t *testing.T) {
+	errDown := errors.New("network down")
+	loader := &stubLoader{results: []stubLoadResult{{pairs: testPairs(btcUsdt)}, {err: errDown}}}
+	r := NewSymbolRegistry(loader.load, time.Hour)
+
+	if _, err := r.Load(); err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	pairs, body, err := r.Refresh()
+	if !errors.Is(err, errDown) || pairs != nil || string(body) != "body" {
+		t.Fatalf("Refresh() = %v, %s, %v, want %v", pairs, body, err, errDown)
+	}
+	if got := r.Pairs(); len(got) != 1 {
+		t.Errorf("Pairs() after failed refresh = %v, want the previous snapshot", got)
+	}
+	if _, ok := r.BySymbol("BTCUSDT"); !ok {
+		t.Errorf("BySymbol(BTCUSDT) not found after failed refresh")
+	}
+}
+
+func TestSymbolRegistryOnChange(t *testing.T) {
+	updatedSol := solUsdt
+	updatedSol.PricePrecision = 4
+
+	loader := &stubLoader{results: []stubLoadResult{
+		{pairs: testPairs(btcUsdt, ethUsdt, solUsdt)},
+		{pairs: testPairs(btcUsdt, ethUsdt, solUsdt)},
+		{pairs: testPairs(btcUsdt, updatedSol, model.CurrencyPair{Symbol: "XRPUSDT", BaseSymbol: "XRP", QuoteSymbol: "USDT"})},
+	}}
+	r := NewSymbolRegistry(loader.load, time.Hour)
+
+	var changes []SymbolChange
+	r.OnChange(func(change SymbolChange) {
+		changes = append(changes, change)
+	})
+
+	symbols := func(pairs []model.CurrencyPair) []string {
+		s := make([]string, 0, len(pairs))
+		for _, p := range pairs {
+			s = append(s, p.Symbol)
+		}
+		sort.Strings(s)
+		return s
+	}
+
+	if _, err := r.Load(); err != nil || len(changes) != 0 {
+		t.Fatalf("first load: err = %v, changes = %v, want no callback", err, changes)
+	}
+	if _, _, err := r.Refresh(); err != nil || len(changes) != 0 {
+		t.Fatalf("unchanged refresh: err = %v, changes = %v, want no callback", err, changes)
+	}
+	if _, _, err := r.Refresh(); err != nil || len(changes) != 1 {
+		t.Fatalf("changed refresh: err = %v, changes = %v, want one callback", err, changes)
+	}
+
+	change := changes[0]
+	if got := symbols(change.Added); len(got) != 1 || got[0] != "XRPUSDT" {
+		t.Errorf("Added = %v, want [XRPUSDT]", got)
+	}
+	if got := symbols(change.Removed); len(got) != 1 || got[0] != "ETHUSDT" {
+		t.Errorf("Removed = %v, want [ETHUSDT]", got)
+	}
+	if len(change.Updated) != 1 || change.Updated[0] != updatedSol {
+		t.Errorf("Updated = %v, want [%+v]", change.Updated, updatedSol)
+	}
+}
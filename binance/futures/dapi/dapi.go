@@ -0,0 +1,127 @@
+package dapi
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/options"
+)
+
+// DApi 币安币本位合约API实现
+// 包含币安币本位永续合约和交割合约的API接口实现
+type DApi struct {
+	symbolRegistry *common.SymbolRegistry //交易对信息，REST和WebSocket共用
+
+	UriOpts       options.UriOptions
+	UnmarshalOpts options.UnmarshalerOptions
+}
+
+// NewDApi 创建币安币本位合约API实例
+// 返回值:
+//   - *DApi: 币安币本位合约API实例
+//
+// 注意:
+//   - 此方法会初始化API的URI和反序列化选项
+//   - 默认使用币安币本位合约的API端点
+//   - 与U本位合约返回格式一致的接口复用fapi包的反序列化方法
+func NewDApi() *DApi {
+	d := &DApi{
+		UriOpts: options.UriOptions{
+			Endpoint:                 "https://dapi.binance.com",
+			KlineUri:                 "/dapi/v1/klines",
+			TickerUri:                "/dapi/v1/ticker/24hr",
+			BookTickerUri:            "/dapi/v1/ticker/bookTicker",
+			DepthUri:                 "/dapi/v1/depth",
+			NewOrderUri:              "/dapi/v1/order",
+			GetOrderUri:              "/dapi/v1/order",
+			GetHistoryOrdersUri:      "/dapi/v1/allOrders",
+			GetPendingOrdersUri:      "/dapi/v1/openOrders",
+			CancelOrderUri:           "/dapi/v1/order",
+			GetAccountUri:            "/dapi/v1/balance",
+			GetFuturesAccountUri:     "/dapi/v1/account",
+			GetPositionsUri:          "/dapi/v1/positionRisk",
+			GetExchangeInfoUri:       "/dapi/v1/exchangeInfo",
+			GetFundingRateUri:        "/dapi/v1/premiumIndex",
+			GetFundingRateHistoryUri: "/dapi/v1/fundingRate",
+			SetPositionModeUri:       "/dapi/v1/positionSide/dual",
+			GetPositionModeUri:       "/dapi/v1/positionSide/dual",
+			SetLeverageUri:           "/dapi/v1/leverage",
+			SetMarginTypeUri:         "/dapi/v1/marginType",
+		},
+		UnmarshalOpts: options.UnmarshalerOptions{
+			GetExchangeInfoResponseUnmarshaler:       UnmarshalGetExchangeInfoResponse,
+			DepthUnmarshaler:                         fapi.UnmarshalDepthResponse,
+			TickersUnmarshaler:                       fapi.UnmarshalTickersResponse,
+			KlineUnmarshaler:                         fapi.UnmarshalKlinesResponse,
+			GetAccountResponseUnmarshaler:            fapi.UnmarshalGetAccountResponse,
+			CreateOrderResponseUnmarshaler:           fapi.UnmarshalCreateOrderResponse,
+			CancelOrderResponseUnmarshaler:           fapi.UnmarshalCancelOrderResponse,
+			GetOrderInfoResponseUnmarshaler:          fapi.UnmarshalGetOrderInfoResponse,
+			GetPendingOrdersResponseUnmarshaler:      fapi.UnmarshalGetPendingOrdersResponse,
+			GetHistoryOrdersResponseUnmarshaler:      fapi.UnmarshalGetHistoryOrdersResponse,
+			GetPositionsResponseUnmarshaler:          UnmarshalGetPositionsResponse,
+			GetFuturesAccountResponseUnmarshaler:     fapi.UnmarshalGetFuturesAccountResponse,
+			GetFundingRateResponseUnmarshaler:        UnmarshalGetFundingRateResponse,
+			GetFundingRateHistoryResponseUnmarshaler: fapi.UnmarshalGetFundingRateHistoryResponse,
+			SetPositionModeResponseUnmarshaler:       fapi.UnmarshalSetPositionModeResponse,
+			GetPositionModeResponseUnmarshaler:       fapi.UnmarshalGetPositionModeResponse,
+			SetLeverageResponseUnmarshaler:           fapi.UnmarshalSetLeverageResponse,
+		},
+	}
+	d.symbolRegistry = common.NewSymbolRegistry(d.fetchExchangeInfo, common.DefaultSymbolRegistryTTL)
+
+	return d
+}
+
+// SymbolRegistry 返回交易对信息注册表，可以设置有效期、订阅交易对变化，或传给WebSocket共用
+func (d *DApi) SymbolRegistry() *common.SymbolRegistry {
+	return d.symbolRegistry
+}
+
+// WithUriOption 设置URI选项
+// 参数:
+//   - opts: URI选项函数
+//
+// 返回值:
+//   - *DApi: 当前API实例，用于链式调用
+//
+// 使用示例:
+//
+//	api := NewDApi().WithUriOption(
+//	  options.WithEndpoint("https://testnet.binancefuture.com"))
+func (d *DApi) WithUriOption(opts ...options.UriOption) *DApi {
+	for _, opt := range opts {
+		opt(&d.UriOpts)
+	}
+	return d
+}
+
+// WithUnmarshalOption 设置反序列化选项
+// 参数:
+//   - opts: 反序列化选项函数
+//
+// 返回值:
+//   - *DApi: 当前API实例，用于链式调用
+func (d *DApi) WithUnmarshalOption(opts ...options.UnmarshalerOption) *DApi {
+	for _, opt := range opts {
+		opt(&d.UnmarshalOpts)
+	}
+	return d
+}
+
+// NewPrvApi 创建币安币本位合约私有API实例
+// 参数:
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安币本位合约私有API实例
+//
+// 使用示例:
+//
+//	prvApi := dApi.NewPrvApi(
+//	  options.WithApiKey("your-api-key"),
+//	  options.WithApiSecretKey("your-secret-key"))
+func (d *DApi) NewPrvApi(opts ...options.ApiOption) *Prv {
+	api := NewPrvApi(d, opts...)
+	api.AuthClient.UriOpts = d.UriOpts
+	return api
+}
@@ -0,0 +1,471 @@
+package dapi
+
+import (
+	"errors"
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// Prv 币安币本位合约私有API实现
+// 包含需要身份验证的币安币本位合约API接口实现
+type Prv struct {
+	*DApi
+	*common.AuthClient
+
+	positionMode fapi.PositionModeCache //缓存的持仓模式，与U本位合约分开缓存
+}
+
+// NewPrvApi 创建币安币本位合约私有API实例
+// 参数:
+//   - dapi: 币安币本位合约API实例
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安币本位合约私有API实例
+func NewPrvApi(dapi *DApi, opts ...options.ApiOption) *Prv {
+	var prv = &Prv{
+		AuthClient: &common.AuthClient{},
+	}
+	prv.DApi = dapi
+	for _, opt := range opts {
+		opt(&prv.AuthClient.ApiOpts)
+	}
+	return prv
+}
+
+// GetAccount 获取账户资产信息
+// 参数:
+//   - currency: 币种，可为空字符串获取所有币种资产
+//
+// 返回值:
+//   - map[string]model.Account: 账户资产信息，键为币种名称
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetAccount(currency string) (map[string]model.Account, []byte, error) {
+	param := &url.Values{}
+	responseBody, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	accounts, err := p.UnmarshalOpts.GetAccountResponseUnmarshaler(responseBody)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if currency != "" {
+		for coin := range accounts {
+			if coin != currency {
+				delete(accounts, coin)
+			}
+		}
+	}
+
+	return accounts, responseBody, nil
+}
+
+// CreateOrder 创建订单
+// 参数:
+//   - pair: 交易对
+//   - qty: 数量，单位为张，每张价值pair.ContractVal美元
+//   - price: 价格
+//   - side: 订单方向(Futures_OpenBuy/Futures_OpenSell/Futures_CloseBuy/Futures_CloseSell)
+//   - orderTy: 订单类型(OrderType_Limit/OrderType_Market等)
+//   - opt: 可选参数，如ClientOrderID、条件单参数等
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 限价类订单默认使用GTC时效策略，市价类订单忽略price
+//   - 与U本位合约共用fapi.BuildCreateOrderParams，下单前按交易对的Filters规整价格和数量，违反交易规则时直接返回错误
+//   - 币本位合约没有测试下单接口，传入model.OptionParameter{}.TestOrder(true)时返回错误
+//   - 持仓模式的处理与U本位合约一致，首次下单时会自动查询持仓模式，查询失败时返回错误
+//
+// 使用示例:
+//
+//	order, _, err := prvApi.CreateOrder(pair, 1, 60000, model.Futures_OpenBuy, model.OrderType_Limit)
+func (p *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error) {
+	if common.IsTestOrder(false, opt...) {
+		return nil, nil, errors.New("test order is not supported")
+	}
+
+	positionMode, err := p.positionMode.Load(p.GetPositionMode)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	param, err := fapi.BuildCreateOrderParams(pair, qty, price, side, orderTy, positionMode, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseBody, err = p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.NewOrderUri, &param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ord, err := p.UnmarshalOpts.CreateOrderResponseUnmarshaler(responseBody)
+	if ord != nil {
+		ord.Pair = pair
+		ord.Side = side
+		ord.OrderTy = orderTy
+		fapi.FillOrderFieldsFromParams(ord, param)
+	}
+
+	return ord, responseBody, err
+}
+
+// GetOrderInfo 获取订单信息
+// 参数:
+//   - pair: 交易对
+//   - id: 订单ID，为空时需要在opt中传入origClientOrderId
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.Order: 订单信息，数量单位为张
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetOrderInfo(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	if id != "" {
+		param.Set("orderId", id)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetOrderUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	order, err = p.UnmarshalOpts.GetOrderInfoResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	order.Pair = pair
+
+	return order, data, nil
+}
+
+// GetPendingOrders 获取当前未完成订单列表
+// 参数:
+//   - pair: 交易对
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []model.Order: 未完成订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPendingOrders(pair model.CurrencyPair, opt ...model.OptionParameter) (orders []model.Order, responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPendingOrdersUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	orders, err = p.UnmarshalOpts.GetPendingOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range orders {
+		orders[i].Pair = pair
+	}
+
+	return orders, data, nil
+}
+
+// GetHistoryOrders 获取历史订单列表
+// 参数:
+//   - pair: 交易对
+//   - opt: 可选参数，如时间范围等
+//
+// 返回值:
+//   - []model.Order: 历史订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认返回最多500条记录
+func (p *Prv) GetHistoryOrders(pair model.CurrencyPair, opt ...model.OptionParameter) (orders []model.Order, responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("limit", "500")
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetHistoryOrdersUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	orders, err = p.UnmarshalOpts.GetHistoryOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range orders {
+		orders[i].Pair = pair
+	}
+
+	return orders, data, nil
+}
+
+// CancelOrder 取消订单
+// 参数:
+//   - pair: 交易对
+//   - id: 订单ID，为空时需要在opt中传入origClientOrderId
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息，如果为nil则表示取消成功
+func (p *Prv) CancelOrder(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	if id != "" {
+		param.Set("orderId", id)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodDelete, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.CancelOrderUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, p.UnmarshalOpts.CancelOrderResponseUnmarshaler(data)
+}
+
+// GetFuturesAccount 获取币本位合约账户信息
+// 参数:
+//   - currency: 保证金币种，如BTC，可为空字符串获取所有币种
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 合约账户信息，键为保证金币种
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 币本位合约每个保证金币种独立计算权益和保证金率，权益以保证金币种计价
+func (p *Prv) GetFuturesAccount(currency string) (acc map[string]model.FuturesAccount, responseBody []byte, err error) {
+	param := &url.Values{}
+	responseBody, err = p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetFuturesAccountUri, param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	acc, err = p.UnmarshalOpts.GetFuturesAccountResponseUnmarshaler(responseBody)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if currency != "" {
+		for coin := range acc {
+			if coin != currency {
+				delete(acc, coin)
+			}
+		}
+	}
+
+	return acc, responseBody, nil
+}
+
+// GetPositions 获取持仓信息
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.FuturesPosition: 持仓信息列表，Qty单位为张
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - /dapi/v1/positionRisk按pair(如BTCUSD)查询，会同时返回永续和交割合约的持仓，这里只保留pair.Symbol对应的持仓
+func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParameter) (positions []model.FuturesPosition, responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("pair", pair.BaseSymbol+pair.QuoteSymbol)
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPositionsUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	pos, err := p.UnmarshalOpts.GetPositionsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range pos {
+		if pos[i].Pair.Symbol != "" && pos[i].Pair.Symbol != pair.Symbol {
+			continue
+		}
+		pos[i].Pair = pair
+		positions = append(positions, pos[i])
+	}
+
+	return positions, data, nil
+}
+
+// SetLeverage 设置交易对的杠杆倍数
+// 参数:
+//   - pair: 交易对
+//   - lever: 杠杆倍数
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) SetLeverage(pair model.CurrencyPair, lever int, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("leverage", fmt.Sprint(lever))
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetLeverageUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, p.UnmarshalOpts.SetLeverageResponseUnmarshaler(data)
+}
+
+// GetLeverage 获取交易对当前的杠杆倍数
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数
+//
+// 返回值:
+//   - int: 杠杆倍数
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetLeverage(pair model.CurrencyPair, opts ...model.OptionParameter) (int, []byte, error) {
+	positions, data, err := p.GetPositions(pair, opts...)
+	if err != nil {
+		return 0, data, err
+	}
+
+	if len(positions) == 0 {
+		return 0, data, fmt.Errorf("leverage of %s not found", pair.Symbol)
+	}
+
+	return int(positions[0].Lever), data, nil
+}
+
+// SetMarginType 设置交易对的保证金模式
+// 参数:
+//   - pair: 交易对
+//   - marginType: model.ISOLATED_MARGIN_TYPE(逐仓)或model.CROSSED_MARGIN_TYPE(全仓)
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 当前已是目标保证金模式时(币安错误码-4046)视为成功
+func (p *Prv) SetMarginType(pair model.CurrencyPair, marginType string, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("marginType", marginType)
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetMarginTypeUri, param, nil)
+	if code, _ := jsonparser.GetInt(data, "code"); code == -4046 {
+		return data, nil
+	}
+	if err != nil {
+		return data, err
+	}
+
+	return data, fapi.UnmarshalCodeMsgResponse(data)
+}
+
+// SetPositionMode 设置持仓模式
+// 参数:
+//   - mode: model.TWO_WAY_POSITION_MODE(双向持仓)或model.ONE_WAY_POSITION_MODE(单向持仓)
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 币本位合约的持仓模式独立于U本位合约
+//   - 当前已是目标持仓模式时(币安错误码-4059)视为成功
+func (p *Prv) SetPositionMode(mode string, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	switch mode {
+	case model.TWO_WAY_POSITION_MODE:
+		param.Set("dualSidePosition", "true")
+	case model.ONE_WAY_POSITION_MODE:
+		param.Set("dualSidePosition", "false")
+	default:
+		return nil, fmt.Errorf("unknown position mode: %s", mode)
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetPositionModeUri, param, nil)
+	if code, _ := jsonparser.GetInt(data, "code"); code != -4059 {
+		if err != nil {
+			return data, err
+		}
+		if _, err = p.UnmarshalOpts.SetPositionModeResponseUnmarshaler(data); err != nil {
+			return data, err
+		}
+	}
+
+	p.positionMode.Set(mode)
+
+	return data, nil
+}
+
+// GetPositionMode 获取当前持仓模式
+// 参数:
+//   - opts: 可选参数
+//
+// 返回值:
+//   - string: model.TWO_WAY_POSITION_MODE或model.ONE_WAY_POSITION_MODE
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPositionMode(opts ...model.OptionParameter) (string, []byte, error) {
+	param := &url.Values{}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPositionModeUri, param, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	mode, err := p.UnmarshalOpts.GetPositionModeResponseUnmarshaler(data)
+	if err != nil {
+		return "", data, err
+	}
+
+	p.positionMode.Set(mode)
+
+	return mode, data, nil
+}
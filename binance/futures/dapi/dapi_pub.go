@@ -0,0 +1,281 @@
+package dapi
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// DoNoAuthRequest 执行不需要认证的HTTP请求
+// 参数:
+//   - httpMethod: HTTP方法，如GET、POST等
+//   - reqUrl: 请求URL
+//   - params: 请求参数
+//
+// 返回值:
+//   - []byte: 响应数据
+//   - []byte: 响应数据的副本
+//   - error: 错误信息
+func (d *DApi) DoNoAuthRequest(httpMethod, reqUrl string, params *url.Values) ([]byte, []byte, error) {
+	if http.MethodGet == httpMethod {
+		reqUrl += "?" + params.Encode()
+	}
+
+	responseBody, err := Cli.DoRequest(httpMethod, reqUrl, "", nil)
+	return responseBody, responseBody, err
+}
+
+// GetName 获取交易所名称
+// 返回值:
+//   - string: 返回交易所名称，固定为"binance.com"
+func (d *DApi) GetName() string {
+	return "binance.com"
+}
+
+// GetExchangeInfo 获取交易所支持的所有币本位合约信息
+// 返回值:
+//   - map[string]model.CurrencyPair: 交易对信息映射，key为BaseSymbol+QuoteSymbol+ContractAlias，如BTCUSDthis_quarter
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 每次调用都会重新下载并更新SymbolRegistry，NewCurrencyPair会在首次使用时自动加载，无需先调用此方法
+//   - ContractVal为1张合约的面值(USD)，下单数量和持仓数量均以张为单位
+func (d *DApi) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	return d.symbolRegistry.Refresh()
+}
+
+// fetchExchangeInfo 下载并解析交易所信息，作为SymbolRegistry的加载方法
+func (d *DApi) fetchExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	data, body, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.GetExchangeInfoUri, &url.Values{})
+	if err != nil {
+		logger.Errorf("[GetExchangeInfo] http request error, body: %s", string(body))
+		return nil, body, err
+	}
+
+	m, err := d.UnmarshalOpts.GetExchangeInfoResponseUnmarshaler(data)
+	if err != nil {
+		logger.Errorf("[GetExchangeInfo] unmarshaler data error, err: %s", err.Error())
+		return nil, body, err
+	}
+
+	return m, body, err
+}
+
+// NewCurrencyPair 创建新的币本位合约交易对
+// 参数:
+//   - baseSym: 基础货币符号，如BTC
+//   - quoteSym: 计价货币符号，币本位合约为USD
+//   - opts: 可选参数，通过model.Contract_Alias__Opt_Key指定合约类型
+//
+// 返回值:
+//   - model.CurrencyPair: 交易对信息
+//   - error: 错误信息，如果交易对不存在则返回错误
+//
+// 注意:
+//   - 首次调用时自动加载交易所信息，之后超过有效期会在后台刷新
+//   - 默认创建永续合约交易对，如BTCUSD_PERP
+//   - 交割合约传入model.THIS_QUARTER_CONTRACT或model.NEXT_QUARTER_CONTRACT，也兼容币安的CURRENT_QUARTER、NEXT_QUARTER
+//   - 交割合约到期后会重新获取交易对信息，返回alias对应的新合约
+//
+// 使用示例:
+//
+//	pair, err := dApi.NewCurrencyPair(model.BTC, model.USD,
+//	  model.OptionParameter{Key: model.Contract_Alias__Opt_Key, Value: model.THIS_QUARTER_CONTRACT})
+func (d *DApi) NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error) {
+	contractAlias := model.PERPETUAL_CONTRACT
+	for _, opt := range opts {
+		if opt.Key == model.Contract_Alias__Opt_Key {
+			contractAlias = common.AdaptContractTypeToAlias(opt.Value)
+		}
+	}
+
+	return common.ResolveContract(d.symbolRegistry, baseSym, quoteSym, contractAlias, time.Now())
+}
+
+// GetDepth 获取合约的深度数据
+// 参数:
+//   - pair: 交易对信息
+//   - limit: 返回的深度数量
+//   - opt: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - *model.Depth: 深度数据，数量单位为张
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (d *DApi) GetDepth(pair model.CurrencyPair, limit int, opt ...model.OptionParameter) (depth *model.Depth, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(limit))
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.DepthUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	dep, err := d.UnmarshalOpts.DepthUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+	dep.Pair = pair
+
+	return dep, responseBody, nil
+}
+
+// GetTicker 获取合约的行情数据
+// 参数:
+//   - pair: 交易对信息
+//   - opt: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - *model.Ticker: 行情数据，Vol为以张为单位的24小时成交量
+//   - []byte: 24hr行情接口的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 币本位合约的行情接口即使指定symbol也返回数组
+//   - 买一卖一价来自/dapi/v1/ticker/bookTicker
+func (d *DApi) GetTicker(pair model.CurrencyPair, opt ...model.OptionParameter) (ticker *model.Ticker, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.TickerUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	tickers, err := d.UnmarshalOpts.TickersUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ticker, ok := tickers[pair.Symbol]
+	if !ok {
+		return nil, responseBody, fmt.Errorf("ticker of %s not found", pair.Symbol)
+	}
+
+	bookData, _, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.BookTickerUri, &params)
+	if err != nil {
+		return nil, responseBody, fmt.Errorf("get book ticker error: %w, body: %s", err, string(bookData))
+	}
+
+	books, err := d.UnmarshalOpts.TickersUnmarshaler(bookData)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ticker.Pair = pair
+	if book, ok := books[pair.Symbol]; ok {
+		ticker.Buy = book.Buy
+		ticker.Sell = book.Sell
+	}
+
+	return ticker, responseBody, nil
+}
+
+// GetKline 获取合约K线数据
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - opt: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - []model.Kline: K线数据数组，成交量单位为张
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认返回100条数据，可以通过opt参数修改limit值
+func (d *DApi) GetKline(pair model.CurrencyPair, period model.KlinePeriod, opt ...model.OptionParameter) (klines []model.Kline, responseBody []byte, err error) {
+	var param = url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("interval", common.AdaptKlinePeriodToSymbol(period))
+	param.Set("limit", "100")
+
+	util.MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.KlineUri, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	klines, err = d.UnmarshalOpts.KlineUnmarshaler(data)
+
+	for i := range klines {
+		klines[i].Pair = pair
+	}
+
+	return klines, responseBody, err
+}
+
+// GetFundingRate 获取永续合约当前资金费率
+// 参数:
+//   - pair: 交易对信息
+//   - opts: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - *model.FundingRate: 资金费率信息，包含最近资金费率、下次资金费时间、标记价格和指数价格
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (d *DApi) GetFundingRate(pair model.CurrencyPair, opts ...model.OptionParameter) (rate *model.FundingRate, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.GetFundingRateUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rate, err = d.UnmarshalOpts.GetFundingRateResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if rate.Symbol == "" {
+		rate.Symbol = pair.Symbol
+	}
+
+	return rate, responseBody, nil
+}
+
+// GetFundingRateHistory 获取永续合约历史资金费率
+// 参数:
+//   - pair: 交易对信息
+//   - limit: 返回的最大记录数，<=0时使用币安默认值100，最大1000
+//   - opts: 可选参数，支持startTime、endTime(毫秒时间戳)
+//
+// 返回值:
+//   - []model.FundingRate: 历史资金费率列表，按资金费收取时间升序
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (d *DApi) GetFundingRateHistory(pair model.CurrencyPair, limit int, opts ...model.OptionParameter) (rates []model.FundingRate, responseBody []byte, err error) {
+	if limit <= 0 {
+		limit = 100 //币安默认值
+	}
+
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(min(limit, 1000)))
+
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := d.DoNoAuthRequest(http.MethodGet, d.UriOpts.Endpoint+d.UriOpts.GetFundingRateHistoryUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rates, err = d.UnmarshalOpts.GetFundingRateHistoryResponseUnmarshaler(data)
+	return rates, responseBody, err
+}
@@ -0,0 +1,121 @@
+package dapi
+
+import (
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+)
+
+// UnmarshalGetExchangeInfoResponse 解析币本位合约交易所信息响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - map[string]model.CurrencyPair: 交易对信息映射，键为BaseSymbol+QuoteSymbol+ContractAlias
+//   - error: 错误信息
+//
+// 注意:
+//   - contractType转换为合约alias，CURRENT_QUARTER对应this_quarter，NEXT_QUARTER对应next_quarter
+//   - ContractVal为contractSize(1张合约的USD面值)，SettlementCurrency为保证金币种
+//   - 非交易状态(contractStatus!=TRADING)的合约会被忽略
+func UnmarshalGetExchangeInfoResponse(data []byte) (map[string]model.CurrencyPair, error) {
+	var currencyPairMap = make(map[string]model.CurrencyPair, 40)
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			currencyPair model.CurrencyPair
+			status       string
+		)
+
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				currencyPair.Symbol = valStr
+			case "baseAsset":
+				currencyPair.BaseSymbol = valStr
+			case "quoteAsset":
+				currencyPair.QuoteSymbol = valStr
+				currencyPair.ContractValCurrency = valStr
+			case "marginAsset":
+				currencyPair.SettlementCurrency = valStr
+			case "contractType":
+				currencyPair.ContractAlias = common.AdaptContractTypeToAlias(valStr)
+			case "contractStatus":
+				status = valStr
+			case "contractSize":
+				currencyPair.ContractVal = cast.ToFloat64(valStr)
+			case "pricePrecision":
+				currencyPair.PricePrecision = cast.ToInt(valStr)
+			case "quantityPrecision":
+				currencyPair.QtyPrecision = cast.ToInt(valStr)
+			case "deliveryDate":
+				currencyPair.ContractDeliveryDate = cast.ToInt64(valStr)
+			case "onboardDate":
+				currencyPair.ContractOnboardDate = cast.ToInt64(valStr)
+			case "filters":
+				currencyPair.Filters = common.UnmarshalSymbolFilters(val)
+				currencyPair.MinQty = currencyPair.Filters.MinQty
+				currencyPair.MaxQty = currencyPair.Filters.MaxQty
+			}
+			return nil
+		})
+
+		if status != "TRADING" {
+			return
+		}
+
+		k := fmt.Sprintf("%s%s%s", currencyPair.BaseSymbol, currencyPair.QuoteSymbol, currencyPair.ContractAlias)
+		currencyPairMap[k] = currencyPair
+	}, "symbols")
+
+	return currencyPairMap, err
+}
+
+// UnmarshalGetFundingRateResponse 解析当前资金费率响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.FundingRate: 资金费率信息
+//   - error: 错误信息
+//
+// 注意:
+//   - /dapi/v1/premiumIndex指定symbol时同样返回数组，取第一个元素按U本位合约格式解析
+func UnmarshalGetFundingRateResponse(data []byte) (*model.FundingRate, error) {
+	value, _, _, err := jsonparser.Get(data, "[0]")
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal funding rate error: %w, body: %s", err, string(data))
+	}
+	return fapi.UnmarshalGetFundingRateResponse(value)
+}
+
+// UnmarshalGetPositionsResponse 解析持仓响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.FuturesPosition: 持仓列表，Qty单位为张，Pair.Symbol为持仓对应的合约
+//   - error: 错误信息
+//
+// 注意:
+//   - 按U本位合约格式解析，额外保留每条持仓的symbol，用于区分同一pair下的永续和交割合约
+func UnmarshalGetPositionsResponse(data []byte) ([]model.FuturesPosition, error) {
+	positions, err := fapi.UnmarshalGetPositionsResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	i := 0
+	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if i < len(positions) {
+			positions[i].Pair.Symbol, _ = jsonparser.GetString(value, "symbol")
+		}
+		i++
+	})
+
+	return positions, err
+}
@@ -0,0 +1,21 @@
+package dapi
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+)
+
+// WebSocket 币安币本位合约WebSocket客户端
+// 币本位合约的推送格式与U本位合约一致，复用fapi.WebSocketBase，只替换推送地址、listenKey接口和交易对信息
+type WebSocket struct {
+	*fapi.WebSocketBase
+}
+
+// NewWebSocket 创建币安币本位合约WebSocket客户端
+func NewWebSocket(apiKey, secretKey string) *WebSocket {
+	return &WebSocket{
+		WebSocketBase: fapi.NewWebSocketBase(apiKey, secretKey).WithEndpoints(
+			"wss://dstream.binance.com/ws",
+			"https://dapi.binance.com/dapi/v1/listenKey",
+			NewDApi().SymbolRegistry()),
+	}
+}
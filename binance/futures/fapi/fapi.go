@@ -1,14 +1,14 @@
 package fapi
 
 import (
-	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/options"
 )
 
 // FApi 币安期货API实现
 // 包含币安期货交易所的API接口实现
 type FApi struct {
-	currencyPairM map[string]model.CurrencyPair
+	symbolRegistry *common.SymbolRegistry //交易对信息，REST和WebSocket共用
 
 	UriOpts       options.UriOptions
 	UnmarshalOpts options.UnmarshalerOptions
@@ -24,36 +24,71 @@ type FApi struct {
 func NewFApi() *FApi {
 	f := &FApi{
 		UriOpts: options.UriOptions{
-			Endpoint:            "https://fapi.binance.com",
-			KlineUri:            "/fapi/v1/klines",
-			TickerUri:           "/fapi/v1/ticker/24hr",
-			DepthUri:            "/fapi/v1/depth",
-			NewOrderUri:         "/fapi/v1/order",
-			GetOrderUri:         "/fapi/v1/order",
-			GetHistoryOrdersUri: "/fapi/v1/allOrders",
-			GetPendingOrdersUri: "/fapi/v1/openOrders",
-			CancelOrderUri:      "/fapi/v1/order",
-			GetAccountUri:       "/fapi/v2/balance",
-			GetPositionsUri:     "/fapi/v2/positionRisk",
-			GetExchangeInfoUri:  "/fapi/v1/exchangeInfo",
+			Endpoint:                 "https://fapi.binance.com",
+			KlineUri:                 "/fapi/v1/klines",
+			TickerUri:                "/fapi/v1/ticker/24hr",
+			BookTickerUri:            "/fapi/v1/ticker/bookTicker",
+			DepthUri:                 "/fapi/v1/depth",
+			NewOrderUri:              "/fapi/v1/order",
+			TestOrderUri:             "/fapi/v1/order/test",
+			AmendOrderUri:            "/fapi/v1/order",
+			BatchOrdersUri:           "/fapi/v1/batchOrders",
+			GetOrderUri:              "/fapi/v1/order",
+			GetHistoryOrdersUri:      "/fapi/v1/allOrders",
+			GetPendingOrdersUri:      "/fapi/v1/openOrders",
+			CancelOrderUri:           "/fapi/v1/order",
+			GetAccountUri:            "/fapi/v2/balance",
+			GetFuturesAccountUri:     "/fapi/v2/account",
+			GetPositionsUri:          "/fapi/v2/positionRisk",
+			GetExchangeInfoUri:       "/fapi/v1/exchangeInfo",
+			GetFundingRateUri:        "/fapi/v1/premiumIndex",
+			GetFundingRateHistoryUri: "/fapi/v1/fundingRate",
+			SetPositionModeUri:       "/fapi/v1/positionSide/dual",
+			GetPositionModeUri:       "/fapi/v1/positionSide/dual",
+			SetLeverageUri:           "/fapi/v1/leverage",
+			GetLeverageUri:           "/fapi/v2/positionRisk",
+			GetLeverageBracketUri:    "/fapi/v1/leverageBracket",
+			SetMarginTypeUri:         "/fapi/v1/marginType",
+			ModifyPositionMarginUri:  "/fapi/v1/positionMargin",
+			GetFillsUri:              "/fapi/v1/userTrades",
+			GetIncomeHistoryUri:      "/fapi/v1/income",
 		},
 		UnmarshalOpts: options.UnmarshalerOptions{
-			GetExchangeInfoResponseUnmarshaler:  UnmarshalGetExchangeInfoResponse,
-			DepthUnmarshaler:                    UnmarshalDepthResponse,
-			KlineUnmarshaler:                    UnmarshalKlinesResponse,
-			GetAccountResponseUnmarshaler:       UnmarshalGetAccountResponse,
-			CreateOrderResponseUnmarshaler:      UnmarshalCreateOrderResponse,
-			CancelOrderResponseUnmarshaler:      UnmarshalCancelOrderResponse,
-			GetOrderInfoResponseUnmarshaler:     UnmarshalGetOrderInfoResponse,
-			GetPendingOrdersResponseUnmarshaler: UnmarshalGetPendingOrdersResponse,
-			GetHistoryOrdersResponseUnmarshaler: UnmarshalGetHistoryOrdersResponse,
-			GetPositionsResponseUnmarshaler:     UnmarshalGetPositionsResponse,
+			GetExchangeInfoResponseUnmarshaler:       UnmarshalGetExchangeInfoResponse,
+			DepthUnmarshaler:                         UnmarshalDepthResponse,
+			TickerUnmarshaler:                        UnmarshalTickerResponse,
+			TickersUnmarshaler:                       UnmarshalTickersResponse,
+			KlineUnmarshaler:                         UnmarshalKlinesResponse,
+			GetAccountResponseUnmarshaler:            UnmarshalGetAccountResponse,
+			CreateOrderResponseUnmarshaler:           UnmarshalCreateOrderResponse,
+			AmendOrderResponseUnmarshaler:            UnmarshalGetOrderInfoResponse,
+			CancelOrderResponseUnmarshaler:           UnmarshalCancelOrderResponse,
+			GetOrderInfoResponseUnmarshaler:          UnmarshalGetOrderInfoResponse,
+			GetPendingOrdersResponseUnmarshaler:      UnmarshalGetPendingOrdersResponse,
+			GetHistoryOrdersResponseUnmarshaler:      UnmarshalGetHistoryOrdersResponse,
+			GetPositionsResponseUnmarshaler:          UnmarshalGetPositionsResponse,
+			GetFuturesAccountResponseUnmarshaler:     UnmarshalGetFuturesAccountResponse,
+			GetFundingRateResponseUnmarshaler:        UnmarshalGetFundingRateResponse,
+			GetFundingRateHistoryResponseUnmarshaler: UnmarshalGetFundingRateHistoryResponse,
+			SetPositionModeResponseUnmarshaler:       UnmarshalSetPositionModeResponse,
+			GetPositionModeResponseUnmarshaler:       UnmarshalGetPositionModeResponse,
+			SetLeverageResponseUnmarshaler:           UnmarshalSetLeverageResponse,
+			GetLeverageResponseUnmarshaler:           UnmarshalGetLeverageResponse,
+			GetLeverageBracketsResponseUnmarshaler:   UnmarshalGetLeverageBracketsResponse,
+			GetFillsResponseUnmarshaler:              UnmarshalGetFillsResponse,
+			GetIncomeHistoryResponseUnmarshaler:      UnmarshalGetIncomeHistoryResponse,
 		},
 	}
+	f.symbolRegistry = common.NewSymbolRegistry(f.fetchExchangeInfo, common.DefaultSymbolRegistryTTL)
 
 	return f
 }
 
+// SymbolRegistry 返回交易对信息注册表，可以设置有效期、订阅交易对变化，或传给WebSocket共用
+func (f *FApi) SymbolRegistry() *common.SymbolRegistry {
+	return f.symbolRegistry
+}
+
 // WithUriOption 设置URI选项
 // 参数:
 //   - opts: URI选项函数
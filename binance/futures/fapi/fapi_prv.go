@@ -2,12 +2,17 @@ package fapi
 
 import (
 	"errors"
+	"fmt"
+	"github.com/buger/jsonparser"
 	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
 	"github.com/nntaoli-project/goex/v2/util"
+	"github.com/spf13/cast"
+	"math"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 // Prv 币安期货私有API实现
@@ -15,6 +20,10 @@ import (
 type Prv struct {
 	*FApi
 	*common.AuthClient
+
+	positionMode PositionModeCache //缓存的持仓模式
+
+	testMode bool //测试下单模式，开启后CreateOrder只校验不下单
 }
 
 // GetAccount 获取账户资产信息
@@ -58,52 +67,246 @@ func (p *Prv) GetAccount(currency string) (map[string]model.Account, []byte, err
 //   - error: 错误信息
 //
 // 注意:
-//   - 限价单(OrderType_Limit)的价格*数量必须大于等于5.0 USDT
-//   - 默认使用GTC(Good Till Cancel)时效策略
+//   - 下单前按交易对的Filters规整价格(tickSize)和数量(stepSize)，并在本地校验LOT_SIZE、PRICE_FILTER、MIN_NOTIONAL，违反时直接返回错误
+//   - 限价类订单(LIMIT/STOP/TAKE_PROFIT)默认使用GTC(Good Till Cancel)时效策略，市价类订单忽略price
+//   - 条件单参数通过opt传入，如model.OptionParameter{}.StopPrice(29000)、CallbackRate(1)、ClosePosition(true)
+//   - 根据缓存的持仓模式设置参数，首次下单时会自动查询持仓模式，查询失败时返回错误，不会下单:
+//     双向持仓模式下按开平方向设置positionSide(LONG/SHORT)；
+//     单向持仓模式下不设置positionSide，平仓单(Futures_CloseBuy/Futures_CloseSell)设置reduceOnly=true
+//   - 测试下单模式(见WithTestMode)或传入model.OptionParameter{}.TestOrder(true)时使用/fapi/v1/order/test，只校验不下单，返回的订单没有订单ID
 //
 // 使用示例:
 //
 //	order, _, err := prvApi.CreateOrder(
 //	  pair, 0.01, 30000, model.Futures_OpenBuy, model.OrderType_Limit)
 func (p *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error) {
-	if orderTy == model.OrderType_Limit && qty*price < 5.0 { //币安规则
-		return nil, nil, errors.New("MIN NOTIONAL must >= 5.0 USDT")
+	param, err := p.buildCreateOrderParams(pair, qty, price, side, orderTy, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if common.IsTestOrder(p.testMode, opt...) {
+		if p.AuthClient.UriOpts.TestOrderUri == "" {
+			return nil, nil, errors.New("test order is not supported")
+		}
+		responseBody, err = p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.TestOrderUri, &param, nil)
+		if err != nil {
+			return nil, responseBody, err
+		}
+		ord := &model.Order{Pair: pair, Side: side, OrderTy: orderTy, CId: param.Get("newClientOrderId")}
+		FillOrderFieldsFromParams(ord, param)
+		return ord, responseBody, nil
+	}
+
+	responseBody, err = p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.NewOrderUri, &param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ord, err := p.UnmarshalOpts.CreateOrderResponseUnmarshaler(responseBody)
+	if ord != nil {
+		ord.Pair = pair
+		ord.Side = side
+		ord.OrderTy = orderTy
+		FillOrderFieldsFromParams(ord, param)
+	}
+
+	return ord, responseBody, err
+}
+
+// WithTestMode 开启或关闭测试下单模式
+// 参数:
+//   - enabled: 是否开启
+//
+// 返回值:
+//   - *Prv: 当前实例，便于链式调用
+//
+// 注意:
+//   - 开启后CreateOrder/CreateOrders使用/fapi/v1/order/test，只校验参数、签名和交易规则，不会真正下单
+//   - 单次下单可以通过model.OptionParameter{}.TestOrder(true/false)覆盖实例的设置
+//   - 统一账户(papi.Prv.UMPrv)没有测试下单接口，开启测试后下单会返回错误
+//
+// 使用示例:
+//
+//	prvApi := fapi.NewPrvApi(fApi, options.WithApiKey("key"), options.WithApiSecretKey("secret")).WithTestMode(true)
+func (p *Prv) WithTestMode(enabled bool) *Prv {
+	p.testMode = enabled
+	return p
+}
+
+// buildCreateOrderParams 构造下单参数，CreateOrder和CreateOrders共用
+func (p *Prv) buildCreateOrderParams(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (url.Values, error) {
+	positionMode, err := p.positionMode.Load(p.GetPositionMode)
+	if err != nil {
+		return nil, err
+	}
+	return BuildCreateOrderParams(pair, qty, price, side, orderTy, positionMode, opt...)
+}
+
+// BuildCreateOrderParams 构造U本位和币本位合约的下单参数
+// 参数:
+//   - positionMode: 账户的持仓模式，model.ONE_WAY_POSITION_MODE或model.TWO_WAY_POSITION_MODE
+//   - 其余参数与CreateOrder相同
+//
+// 返回值:
+//   - url.Values: 下单参数，已删除只用于选择下单接口的TestOrder参数
+//   - error: 违反交易规则时的错误信息
+//
+// 注意:
+//   - 按交易对的Filters规整价格、触发价格和数量，全部平仓(ClosePosition)时不传入数量和reduceOnly
+//   - 双向持仓模式下按开平方向设置positionSide，单向持仓模式下平仓单设置reduceOnly=true
+func BuildCreateOrderParams(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, positionMode string, opt ...model.OptionParameter) (url.Values, error) {
+	closePosition := false
+	for _, o := range opt {
+		if o.Key == model.Close_Position__Opt_Key {
+			closePosition = o.Value == "true"
+		}
+	}
+
+	if !closePosition { //全部平仓时不传入数量，无需规整
+		var err error
+		if qty, price, err = common.NormalizeOrder(pair, qty, price, side, orderTy, 0, opt...); err != nil {
+			return nil, err
+		}
 	}
 
 	var param = url.Values{}
 	param.Set("symbol", pair.Symbol)
-	param.Set("price", util.FloatToString(price, pair.PricePrecision))
 	param.Set("quantity", util.FloatToString(qty, pair.QtyPrecision))
 	param.Set("type", common.AdaptOrderTypeToString(orderTy))
 	param.Set("side", common.AdaptOrderSideToString(side))
-	param.Set("timeInForce", "GTC")
 	param.Set("newOrderRespType", "ACK")
 
-	switch side {
-	case model.Futures_OpenSell, model.Futures_CloseSell:
-		param.Set("positionSide", "SHORT")
-	case model.Futures_OpenBuy, model.Futures_CloseBuy:
-		param.Set("positionSide", "LONG")
+	if common.IsLimitOrderType(orderTy) {
+		param.Set("price", util.FloatToString(price, pair.PricePrecision))
+		param.Set("timeInForce", "GTC")
+	}
+
+	if positionMode == model.ONE_WAY_POSITION_MODE {
+		switch side {
+		case model.Futures_CloseBuy, model.Futures_CloseSell:
+			param.Set("reduceOnly", "true")
+		}
+	} else {
+		switch side {
+		case model.Futures_OpenSell, model.Futures_CloseSell:
+			param.Set("positionSide", "SHORT")
+		case model.Futures_OpenBuy, model.Futures_CloseBuy:
+			param.Set("positionSide", "LONG")
+		}
 	}
 
 	util.MergeOptionParams(&param, opt...)           //合并参数
 	common.AdaptOrderClientIDOptionParameter(&param) //client id
+	param.Del(model.Test_Order__Opt_Key)             //只用于选择下单接口，不发送给交易所
+
+	if closePosition { //全部平仓时不能传入数量和reduceOnly
+		param.Del("quantity")
+		param.Del("reduceOnly")
+		param.Del(model.Exact_Qty__Opt_Key)
+		param.Del(model.Exact_Price__Opt_Key)
+	} else if err := common.NormalizeExactOrderParams(pair, &param, side, orderTy, opt...); err != nil {
+		return nil, err
+	}
 
-	responseBody, err = p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.NewOrderUri, &param, nil)
+	if stopPrice := param.Get(model.Stop_Price__Opt_Key); stopPrice != "" {
+		sp, err := common.NormalizePrice(pair, cast.ToFloat64(stopPrice))
+		if err != nil {
+			return nil, err
+		}
+		param.Set(model.Stop_Price__Opt_Key, util.FloatToString(sp, pair.PricePrecision))
+	}
+
+	return param, nil
+}
+
+// FillOrderFieldsFromParams 使用实际发送的下单参数填充订单的数量、价格和条件单字段(ACK响应中不包含这些字段)
+// 数量和价格为按stepSize/tickSize规整后的值，与交易所收到的一致，未发送时为0
+func FillOrderFieldsFromParams(ord *model.Order, param url.Values) {
+	ord.Qty = cast.ToFloat64(param.Get("quantity"))
+	ord.Price = cast.ToFloat64(param.Get("price"))
+	ord.StopPrice = cast.ToFloat64(param.Get(model.Stop_Price__Opt_Key))
+	ord.ActivationPrice = cast.ToFloat64(param.Get(model.Activation_Price__Opt_Key))
+	ord.CallbackRate = cast.ToFloat64(param.Get(model.Callback_Rate__Opt_Key))
+	ord.WorkingType = param.Get(model.Working_Type__Opt_Key)
+	ord.PriceProtect = cast.ToBool(param.Get(model.Price_Protect__Opt_Key))
+	ord.ClosePosition = cast.ToBool(param.Get(model.Close_Position__Opt_Key))
+}
+
+// AmendOrder 修改未成交订单的数量和价格
+// 参数:
+//   - pair: 交易对
+//   - id: 订单ID，为空时需要在opt中传入origClientOrderId
+//   - newQty: 新的数量
+//   - newPrice: 新的价格
+//   - opt: 可选参数，如side、priceMatch等
+//
+// 返回值:
+//   - *model.Order: 修改后的订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 只支持修改限价单，修改后订单保持原订单ID
+//   - 与CreateOrder一样按交易对的Filters规整价格和数量，违反交易规则时直接返回错误
+//   - 币安要求传入订单方向，opt中未传入side时会先查询订单获取，平仓单与CreateOrder一样不校验最小名义价值
+//   - opt中的side可以传入model.Futures_CloseBuy等方向，传入BUY/SELL时无法判断是否为平仓单，会校验最小名义价值
+//
+// 使用示例:
+//
+//	order, _, err := prvApi.AmendOrder(pair, "123456", 0.01, 30100)
+func (p *Prv) AmendOrder(pair model.CurrencyPair, id string, newQty, newPrice float64, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	if id != "" {
+		param.Set("orderId", id)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	var side model.OrderSide
+	switch sideStr := param.Get("side"); sideStr {
+	case "":
+		var queryOpts []model.OptionParameter
+		if cid := param.Get("origClientOrderId"); cid != "" {
+			queryOpts = append(queryOpts, model.OptionParameter{Key: "origClientOrderId", Value: cid})
+		}
+
+		ord, body, err := p.GetOrderInfo(pair, id, queryOpts...)
+		if err != nil {
+			return nil, body, fmt.Errorf("query order side error: %w", err)
+		}
+
+		side = ord.Side
+		param.Set("side", common.AdaptOrderSideToString(side))
+	case string(model.Futures_OpenBuy), string(model.Futures_OpenSell), string(model.Futures_CloseBuy), string(model.Futures_CloseSell):
+		side = model.OrderSide(sideStr)
+		param.Set("side", common.AdaptOrderSideToString(side))
+	}
+
+	qty, price, err := common.NormalizeOrder(pair, newQty, newPrice, side, model.OrderType_Limit, 0, opt...) //refPrice为0时side只用于判断是否为平仓单
 	if err != nil {
-		return nil, responseBody, err
+		return nil, nil, err
+	}
+	param.Set("quantity", util.FloatToString(qty, pair.QtyPrecision))
+	param.Set("price", util.FloatToString(price, pair.PricePrecision))
+	if err = common.NormalizeExactOrderParams(pair, param, side, model.OrderType_Limit, opt...); err != nil {
+		return nil, nil, err
 	}
 
-	ord, err := p.UnmarshalOpts.CreateOrderResponseUnmarshaler(responseBody)
-	if ord != nil {
-		ord.Pair = pair
-		ord.Price = price
-		ord.Qty = qty
-		ord.Side = side
-		ord.OrderTy = orderTy
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPut, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.AmendOrderUri, param, nil)
+	if err != nil {
+		return nil, data, err
 	}
 
-	return ord, responseBody, err
+	order, err = p.UnmarshalOpts.AmendOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	order.Pair = pair
+
+	return order, data, nil
 }
 
 // GetOrderInfo 获取订单信息
@@ -127,7 +330,9 @@ func (p *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side mode
 func (p *Prv) GetOrderInfo(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (order *model.Order, responseBody []byte, err error) {
 	param := &url.Values{}
 	param.Set("symbol", pair.Symbol)
-	param.Set("orderId", id)
+	if id != "" {
+		param.Set("orderId", id)
+	}
 
 	util.MergeOptionParams(param, opt...)
 
@@ -271,17 +476,64 @@ func (p *Prv) CancelOrder(pair model.CurrencyPair, id string, opt ...model.Optio
 
 // GetFuturesAccount 获取期货账户信息
 // 参数:
-//   - currency: 币种
+//   - currency: 币种，可为空字符串获取所有币种资产
 //
 // 返回值:
-//   - map[string]model.FuturesAccount: 期货账户信息
+//   - map[string]model.FuturesAccount: 期货账户信息，键为币种名称
 //   - []byte: API响应原始数据
 //   - error: 错误信息
 //
 // 注意:
-//   - 此方法尚未实现，调用会导致panic
+//   - 数据来自/fapi/v2/account，包含权益、可用权益、未实现盈亏、保证金率
+//
+// 使用示例:
+//
+//	accounts, _, err := prvApi.GetFuturesAccount(model.USDT)
+//	if err != nil {
+//	  // 处理错误
+//	}
+//	usdt := accounts[model.USDT]
 func (p *Prv) GetFuturesAccount(currency string) (acc map[string]model.FuturesAccount, responseBody []byte, err error) {
-	panic("not implement")
+	param := &url.Values{}
+	responseBody, err = p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetFuturesAccountUri, param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	acc, err = p.UnmarshalOpts.GetFuturesAccountResponseUnmarshaler(responseBody)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if currency != "" {
+		for coin := range acc {
+			if coin != currency {
+				delete(acc, coin)
+			}
+		}
+	}
+
+	return acc, responseBody, nil
+}
+
+// GetMultiAssetsAccount 获取期货账户汇总信息
+// 返回值:
+//   - *model.FuturesAccount: 账户汇总信息，Coin固定为USD
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 联合保证金模式下为所有资产折合USD的汇总权益、可用权益、未实现盈亏、保证金率
+//   - 单币保证金模式下为USDT资产的对应值
+func (p *Prv) GetMultiAssetsAccount() (*model.FuturesAccount, []byte, error) {
+	param := &url.Values{}
+	responseBody, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetFuturesAccountUri, param, nil)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	acc, err := UnmarshalGetMultiAssetsAccountResponse(responseBody)
+	return acc, responseBody, err
 }
 
 // GetPositions 获取持仓信息
@@ -326,7 +578,260 @@ func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParamete
 	return pos, data, nil
 }
 
+// SetLeverage 设置交易对的杠杆倍数
+// 参数:
+//   - pair: 交易对
+//   - lever: 杠杆倍数
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	_, err := prvApi.SetLeverage(pair, 10)
+func (p *Prv) SetLeverage(pair model.CurrencyPair, lever int, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("leverage", fmt.Sprint(lever))
 
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetLeverageUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, p.UnmarshalOpts.SetLeverageResponseUnmarshaler(data)
+}
+
+// GetLeverage 获取交易对当前的杠杆倍数
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数
+//
+// 返回值:
+//   - int: 杠杆倍数
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 数据来自持仓风险接口(/fapi/v2/positionRisk)，无持仓时同样返回当前杠杆设置
+func (p *Prv) GetLeverage(pair model.CurrencyPair, opts ...model.OptionParameter) (int, []byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetLeverageUri, param, nil)
+	if err != nil {
+		return 0, data, err
+	}
+
+	lever, err := p.UnmarshalOpts.GetLeverageResponseUnmarshaler(data)
+	if err != nil {
+		return 0, data, err
+	}
+
+	return cast.ToInt(lever), data, nil
+}
+
+// GetLeverageBrackets 获取交易对的杠杆分层标准
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.LeverageBracket: 杠杆分层列表，包含各名义价值区间允许的最高杠杆和维持保证金率
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetLeverageBrackets(pair model.CurrencyPair, opts ...model.OptionParameter) ([]model.LeverageBracket, []byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetLeverageBracketUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	brackets, err := p.UnmarshalOpts.GetLeverageBracketsResponseUnmarshaler(data)
+	return brackets, data, err
+}
+
+// SetMarginType 设置交易对的保证金模式
+// 参数:
+//   - pair: 交易对
+//   - marginType: model.ISOLATED_MARGIN_TYPE(逐仓)或model.CROSSED_MARGIN_TYPE(全仓)
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 当前已是目标保证金模式时(币安错误码-4046)视为成功
+func (p *Prv) SetMarginType(pair model.CurrencyPair, marginType string, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("marginType", marginType)
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetMarginTypeUri, param, nil)
+	if code, _ := jsonparser.GetInt(data, "code"); code == -4046 {
+		return data, nil
+	}
+	if err != nil {
+		return data, err
+	}
+
+	return data, UnmarshalCodeMsgResponse(data)
+}
+
+// ModifyIsolatedPositionMargin 调整逐仓保证金
+// 参数:
+//   - pair: 交易对
+//   - amount: 调整数量，大于0增加保证金，小于0减少保证金
+//   - opts: 可选参数，双向持仓模式下需要传入positionSide(LONG/SHORT)
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	_, err := prvApi.ModifyIsolatedPositionMargin(pair, -10,
+//	  model.OptionParameter{Key: "positionSide", Value: "LONG"})
+func (p *Prv) ModifyIsolatedPositionMargin(pair model.CurrencyPair, amount float64, opts ...model.OptionParameter) ([]byte, error) {
+	if amount == 0 {
+		return nil, errors.New("amount must not be zero")
+	}
+
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("amount", util.FloatToString(math.Abs(amount), 8))
+	if amount > 0 {
+		param.Set("type", "1") //增加逐仓保证金
+	} else {
+		param.Set("type", "2") //减少逐仓保证金
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.ModifyPositionMarginUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, UnmarshalCodeMsgResponse(data)
+}
+
+// SetPositionMode 设置持仓模式
+// 参数:
+//   - mode: model.TWO_WAY_POSITION_MODE(双向持仓)或model.ONE_WAY_POSITION_MODE(单向持仓)
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 设置成功后会更新缓存的持仓模式，后续CreateOrder按新模式下单
+//   - 当前已是目标持仓模式时(币安错误码-4059)视为成功
+func (p *Prv) SetPositionMode(mode string, opts ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	switch mode {
+	case model.TWO_WAY_POSITION_MODE:
+		param.Set("dualSidePosition", "true")
+	case model.ONE_WAY_POSITION_MODE:
+		param.Set("dualSidePosition", "false")
+	default:
+		return nil, fmt.Errorf("unknown position mode: %s", mode)
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.SetPositionModeUri, param, nil)
+	if code, _ := jsonparser.GetInt(data, "code"); code != -4059 {
+		if err != nil {
+			return data, err
+		}
+		if _, err = p.UnmarshalOpts.SetPositionModeResponseUnmarshaler(data); err != nil {
+			return data, err
+		}
+	}
+
+	p.positionMode.Set(mode)
+
+	return data, nil
+}
+
+// GetPositionMode 获取当前持仓模式
+// 参数:
+//   - opts: 可选参数
+//
+// 返回值:
+//   - string: model.TWO_WAY_POSITION_MODE或model.ONE_WAY_POSITION_MODE
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 每次调用都会请求接口，并刷新缓存的持仓模式
+func (p *Prv) GetPositionMode(opts ...model.OptionParameter) (string, []byte, error) {
+	param := &url.Values{}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPositionModeUri, param, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	mode, err := p.UnmarshalOpts.GetPositionModeResponseUnmarshaler(data)
+	if err != nil {
+		return "", data, err
+	}
+
+	p.positionMode.Set(mode)
+
+	return mode, data, nil
+}
+
+// PositionModeCache 缓存账户的持仓模式，U本位和币本位合约私有接口共用，零值可用
+type PositionModeCache struct {
+	mu   sync.RWMutex
+	mode string //为空表示尚未获取
+}
+
+// Set 更新缓存的持仓模式，设置或查询持仓模式成功后调用
+func (c *PositionModeCache) Set(mode string) {
+	c.mu.Lock()
+	c.mode = mode
+	c.mu.Unlock()
+}
+
+// Load 返回缓存的持仓模式，未缓存时通过query(如Prv.GetPositionMode)查询并缓存
+// 查询失败时返回错误，不猜测持仓模式，避免单向持仓账户传入positionSide被拒绝、平仓单缺少reduceOnly
+func (c *PositionModeCache) Load(query func(opts ...model.OptionParameter) (string, []byte, error)) (string, error) {
+	c.mu.RLock()
+	mode := c.mode
+	c.mu.RUnlock()
+
+	if mode != "" {
+		return mode, nil
+	}
+
+	mode, _, err := query()
+	if err != nil {
+		return "", fmt.Errorf("get position mode error: %w", err)
+	}
+	c.Set(mode)
+
+	return mode, nil
+}
 
 // NewPrvApi 创建币安期货私有API实例
 // 参数:
@@ -334,7 +839,7 @@ func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParamete
 //   - opts: API选项，如API密钥、密钥等
 //
 // 返回值:
-//   - *Prv: 币安期货私有API实例
+//   - *Prv: 币安期货私有API实例，使用fapi的URI选项
 //
 // 使用示例:
 //
@@ -343,7 +848,7 @@ func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParamete
 //	  options.WithApiSecretKey("your-secret-key"))
 func NewPrvApi(fapi *FApi, opts ...options.ApiOption) *Prv {
 	var prv = &Prv{
-		AuthClient: &common.AuthClient{},
+		AuthClient: &common.AuthClient{UriOpts: fapi.UriOpts},
 	}
 	prv.FApi = fapi
 	for _, opt := range opts {
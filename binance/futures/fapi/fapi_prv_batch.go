@@ -0,0 +1,226 @@
+package fapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	batchCreateOrdersSize = 5  //币安批量下单每次最多5个订单
+	batchCancelOrdersSize = 10 //币安批量撤单每次最多10个订单
+)
+
+// errBatchOrdersNotSupported 未配置BatchOrdersUri时返回，如统一账户的U本位合约
+var errBatchOrdersNotSupported = errors.New("batch orders not supported")
+
+// CreateOrders 批量下单
+// 参数:
+//   - reqs: 订单参数列表，可以包含不同交易对
+//
+// 返回值:
+//   - []model.BatchOrderResult: 与reqs一一对应的下单结果
+//   - error: 整体性错误，如reqs为空
+//
+// 注意:
+//   - 使用/fapi/v1/batchOrders，每5个订单一次请求
+//   - 单个订单失败时错误记录在对应结果的Err中，不影响其他订单
+//   - 测试下单模式下逐个调用/fapi/v1/order/test
+//   - 未配置BatchOrdersUri时(如统一账户)返回错误
+//
+// 使用示例:
+//
+//	results, err := prvApi.CreateOrders([]model.BatchOrderReq{
+//	  {Pair: pair, Qty: 0.01, Price: 30000, Side: model.Futures_OpenBuy, OrderTy: model.OrderType_Limit},
+//	  {Pair: pair, Qty: 0.01, Price: 31000, Side: model.Futures_OpenSell, OrderTy: model.OrderType_Limit},
+//	})
+func (p *Prv) CreateOrders(reqs []model.BatchOrderReq) ([]model.BatchOrderResult, error) {
+	if len(reqs) == 0 {
+		return nil, errors.New("empty batch order requests")
+	}
+	if p.AuthClient.UriOpts.BatchOrdersUri == "" {
+		return nil, errBatchOrdersNotSupported
+	}
+
+	results := make([]model.BatchOrderResult, len(reqs))
+
+	for start := 0; start < len(reqs); start += batchCreateOrdersSize {
+		end := min(start+batchCreateOrdersSize, len(reqs))
+
+		var (
+			batch   []map[string]string
+			indexes []int //batch中每个订单在reqs中的下标
+			params  = make(map[int]url.Values, batchCreateOrdersSize)
+		)
+
+		for i := start; i < end; i++ {
+			req := reqs[i]
+			if common.IsTestOrder(p.testMode, req.Opts...) { //批量下单没有测试接口，逐个测试下单
+				results[i].Order, _, results[i].Err = p.CreateOrder(req.Pair, req.Qty, req.Price, req.Side, req.OrderTy, req.Opts...)
+				continue
+			}
+
+			param, err := p.buildCreateOrderParams(req.Pair, req.Qty, req.Price, req.Side, req.OrderTy, req.Opts...)
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+
+			params[i] = param
+
+			item := make(map[string]string, len(param))
+			for k := range param {
+				item[k] = param.Get(k)
+			}
+			batch = append(batch, item)
+			indexes = append(indexes, i)
+		}
+
+		if len(batch) == 0 {
+			continue
+		}
+
+		p.doBatchRequest(http.MethodPost, "batchOrders", batch, indexes, results, func(i int, ord *model.Order) {
+			req := reqs[i]
+			ord.Pair = req.Pair
+			ord.Side = req.Side
+			ord.OrderTy = req.OrderTy
+			FillOrderFieldsFromParams(ord, params[i])
+		})
+	}
+
+	return results, nil
+}
+
+// CancelOrders 批量撤单
+// 参数:
+//   - pair: 交易对
+//   - ids: 订单ID列表
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []model.BatchOrderResult: 与ids一一对应的撤单结果，成功时Order为撤单后的订单信息
+//   - error: 整体性错误，如ids为空
+//
+// 注意:
+//   - 使用/fapi/v1/batchOrders，每10个订单一次请求
+//   - 单个订单撤单失败时错误记录在对应结果的Err中，不影响其他订单，格式错误的订单ID不会发送
+//   - 未配置BatchOrdersUri时(如统一账户)返回错误
+func (p *Prv) CancelOrders(pair model.CurrencyPair, ids []string, opt ...model.OptionParameter) ([]model.BatchOrderResult, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("empty order ids")
+	}
+	if p.AuthClient.UriOpts.BatchOrdersUri == "" {
+		return nil, errBatchOrdersNotSupported
+	}
+
+	results := make([]model.BatchOrderResult, len(ids))
+
+	for start := 0; start < len(ids); start += batchCancelOrdersSize {
+		end := min(start+batchCancelOrdersSize, len(ids))
+
+		var (
+			orderIds []int64
+			indexes  []int
+		)
+
+		for i := start; i < end; i++ {
+			orderId, err := strconv.ParseInt(ids[i], 10, 64)
+			if err != nil { //格式错误的订单ID不发送，避免被当作orderId 0
+				results[i].Err = fmt.Errorf("invalid order id %q: %w", ids[i], err)
+				continue
+			}
+			orderIds = append(orderIds, orderId)
+			indexes = append(indexes, i)
+		}
+
+		if len(orderIds) == 0 {
+			continue
+		}
+
+		extra := append([]model.OptionParameter{{Key: "symbol", Value: pair.Symbol}}, opt...)
+		p.doBatchRequest(http.MethodDelete, "orderIdList", orderIds, indexes, results, func(i int, ord *model.Order) {
+			ord.Pair = pair
+		}, extra...)
+	}
+
+	return results, nil
+}
+
+// doBatchRequest 发送一次批量请求，并将结果按indexes写入results
+// 参数:
+//   - method: HTTP方法
+//   - key: 批量参数名，batchOrders或orderIdList
+//   - batch: 批量参数，会序列化为JSON
+//   - indexes: batch中每个元素在results中的下标
+//   - results: 结果列表
+//   - fill: 成功时补充订单信息
+//   - extra: 额外的请求参数
+//
+// 注意:
+//   - 整个请求失败时，该批次中尚无结果的订单都记录同一个错误
+func (p *Prv) doBatchRequest(method, key string, batch interface{}, indexes []int, results []model.BatchOrderResult, fill func(int, *model.Order), extra ...model.OptionParameter) {
+	setErr := func(err error) {
+		for _, i := range indexes {
+			if results[i].Order == nil && results[i].Err == nil {
+				results[i].Err = err
+			}
+		}
+	}
+
+	batchJson, err := json.Marshal(batch)
+	if err != nil {
+		setErr(err)
+		return
+	}
+
+	param := &url.Values{}
+	param.Set(key, string(batchJson))
+	util.MergeOptionParams(param, extra...)
+
+	data, err := p.AuthClient.DoAuthRequest(method, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.BatchOrdersUri, param, nil)
+	if err != nil {
+		if len(data) > 0 {
+			err = errors.New(string(data))
+		}
+		setErr(err)
+		return
+	}
+
+	var unmarshal func([]byte) (*model.Order, error) = p.UnmarshalOpts.GetOrderInfoResponseUnmarshaler //撤单返回完整订单信息
+	if method == http.MethodPost {
+		unmarshal = p.UnmarshalOpts.CreateOrderResponseUnmarshaler
+	}
+
+	n := 0
+	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, _ error) {
+		if n >= len(indexes) {
+			return
+		}
+		i := indexes[n]
+		n++
+
+		if code, e := jsonparser.GetInt(value, "code"); e == nil && code != 0 && code != 200 {
+			results[i].Err = errors.New(string(value))
+			return
+		}
+
+		ord, e := unmarshal(value)
+		if e != nil {
+			results[i].Err = e
+			return
+		}
+		fill(i, ord)
+		results[i].Order = ord
+	})
+	if err != nil {
+		setErr(err)
+	}
+}
@@ -0,0 +1,178 @@
+package fapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCreateOrdersMapsResultsToRequests(t *testing.T) {
+	var batchSizes []int
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		if method != http.MethodPost {
+			return nil, fmt.Errorf("unexpected request %s %s", method, reqUrl)
+		}
+
+		var batch []map[string]string
+		if err := json.Unmarshal([]byte(queryParams(t, reqUrl).Get("batchOrders")), &batch); err != nil {
+			t.Fatalf("unmarshal batchOrders error: %v", err)
+		}
+		batchSizes = append(batchSizes, len(batch))
+
+		//数量为0.666的订单保证金不足，其余成功，响应与请求顺序一致
+		resp := make([]json.RawMessage, 0, len(batch))
+		for n, item := range batch {
+			if item["quantity"] == "0.666" {
+				resp = append(resp, json.RawMessage(`{"code":-2019,"msg":"Margin is insufficient."}`))
+				continue
+			}
+			resp = append(resp, json.RawMessage(fmt.Sprintf(`{"orderId":%d,"clientOrderId":"%s","symbol":"%s"}`, 1000+len(batchSizes)*10+n, item["newClientOrderId"], item["symbol"])))
+		}
+		return json.Marshal(resp)
+	})
+
+	prv := newTestPrv()
+	prv.positionMode.Set(model.ONE_WAY_POSITION_MODE)
+
+	pair := model.CurrencyPair{Symbol: "BTCUSDT", PricePrecision: 1, QtyPrecision: 3, Filters: model.SymbolFilters{MinQty: 0.001, StepSize: 0.001}}
+	reqs := make([]model.BatchOrderReq, 12)
+	for i := range reqs {
+		reqs[i] = model.BatchOrderReq{
+			Pair: pair, Qty: 0.01, Price: 30000, Side: model.Futures_OpenBuy, OrderTy: model.OrderType_Limit,
+			Opts: []model.OptionParameter{model.OptionParameter{}.OrderClientID(fmt.Sprintf("c%d", i))},
+		}
+	}
+	reqs[3].Qty = 0.666  //交易所拒绝
+	reqs[7].Qty = 0.0001 //本地校验失败，不发送
+	reqs[11].Qty = 0.666 //最后一批中的失败
+
+	results, err := prv.CreateOrders(reqs)
+	if err != nil {
+		t.Fatalf("CreateOrders() error = %v", err)
+	}
+	if len(results) != len(reqs) {
+		t.Fatalf("got %d results, want %d", len(results), len(reqs))
+	}
+	if want := []int{5, 4, 2}; fmt.Sprint(batchSizes) != fmt.Sprint(want) {
+		t.Errorf("batch sizes = %v, want %v", batchSizes, want)
+	}
+
+	for i, r := range results {
+		switch i {
+		case 3, 11:
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "-2019") || r.Order != nil {
+				t.Errorf("result %d = %+v, want exchange error", i, r)
+			}
+		case 7:
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "minQty") || r.Order != nil {
+				t.Errorf("result %d = %+v, want local filter error", i, r)
+			}
+		default:
+			if r.Err != nil || r.Order == nil {
+				t.Errorf("result %d = %+v, want success", i, r)
+				continue
+			}
+			if r.Order.CId != fmt.Sprintf("c%d", i) {
+				t.Errorf("result %d has client id %q, want c%d", i, r.Order.CId, i)
+			}
+			if r.Order.Qty != 0.01 || r.Order.Price != 30000 || r.Order.Side != model.Futures_OpenBuy || r.Order.Pair.Symbol != pair.Symbol {
+				t.Errorf("result %d order = %+v, want fields from the request", i, r.Order)
+			}
+		}
+	}
+}
+
+func TestCancelOrdersMapsResultsToIds(t *testing.T) {
+	var batchSizes []int
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		if method != http.MethodDelete {
+			return nil, fmt.Errorf("unexpected request %s %s", method, reqUrl)
+		}
+
+		var ids []int64
+		if err := json.Unmarshal([]byte(queryParams(t, reqUrl).Get("orderIdList")), &ids); err != nil {
+			t.Fatalf("unmarshal orderIdList error: %v", err)
+		}
+		batchSizes = append(batchSizes, len(ids))
+
+		//订单ID为7的倍数时订单不存在，其余撤单成功
+		resp := make([]json.RawMessage, 0, len(ids))
+		for _, id := range ids {
+			if id%7 == 0 {
+				resp = append(resp, json.RawMessage(`{"code":-2011,"msg":"Unknown order sent."}`))
+				continue
+			}
+			resp = append(resp, json.RawMessage(fmt.Sprintf(`{"orderId":%d,"symbol":"BTCUSDT","status":"CANCELED"}`, id)))
+		}
+		return json.Marshal(resp)
+	})
+
+	ids := make([]string, 23)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+	ids[4] = "12abc" //格式错误，不发送
+
+	results, err := newTestPrv().CancelOrders(model.CurrencyPair{Symbol: "BTCUSDT"}, ids)
+	if err != nil {
+		t.Fatalf("CancelOrders() error = %v", err)
+	}
+	if want := []int{9, 10, 3}; fmt.Sprint(batchSizes) != fmt.Sprint(want) {
+		t.Errorf("batch sizes = %v, want %v", batchSizes, want)
+	}
+
+	for i, r := range results {
+		id, _ := strconv.Atoi(ids[i])
+		switch {
+		case i == 4:
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "invalid order id") {
+				t.Errorf("result %d = %+v, want invalid id error", i, r)
+			}
+		case id%7 == 0:
+			if r.Err == nil || !strings.Contains(r.Err.Error(), "-2011") || r.Order != nil {
+				t.Errorf("result %d = %+v, want exchange error", i, r)
+			}
+		default:
+			if r.Err != nil || r.Order == nil || r.Order.Id != ids[i] || r.Order.Pair.Symbol != "BTCUSDT" {
+				t.Errorf("result %d = %+v, want order %s canceled", i, r, ids[i])
+			}
+		}
+	}
+}
+
+func TestCancelOrdersRequestError(t *testing.T) {
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		return []byte(`{"code":-1003,"msg":"Too many requests."}`), errors.New("429 Too Many Requests")
+	})
+
+	results, err := newTestPrv().CancelOrders(model.CurrencyPair{Symbol: "BTCUSDT"}, []string{"1", "x", "3"})
+	if err != nil {
+		t.Fatalf("CancelOrders() error = %v", err)
+	}
+	for i, r := range results {
+		want := "-1003"
+		if i == 1 {
+			want = "invalid order id"
+		}
+		if r.Err == nil || !strings.Contains(r.Err.Error(), want) {
+			t.Errorf("result %d error = %v, want %q", i, r.Err, want)
+		}
+	}
+}
+
+func TestBatchOrdersNotSupported(t *testing.T) {
+	prv := newTestPrv()
+	prv.AuthClient.UriOpts.BatchOrdersUri = ""
+
+	if _, err := prv.CreateOrders([]model.BatchOrderReq{{}}); !errors.Is(err, errBatchOrdersNotSupported) {
+		t.Errorf("CreateOrders() error = %v, want %v", err, errBatchOrdersNotSupported)
+	}
+	if _, err := prv.CancelOrders(model.CurrencyPair{}, []string{"1"}); !errors.Is(err, errBatchOrdersNotSupported) {
+		t.Errorf("CancelOrders() error = %v, want %v", err, errBatchOrdersNotSupported)
+	}
+}
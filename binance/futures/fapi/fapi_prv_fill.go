@@ -0,0 +1,54 @@
+package fapi
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	fillPageSize = 1000                            //成交明细接口单次最多返回1000条
+	fillWindow   = int64(7 * 24 * time.Hour / 1e6) //成交明细接口单次查询的最大时间跨度(毫秒)
+)
+
+// GetFills 获取账户成交明细
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数，支持fromId、startTime、endTime(毫秒时间戳)、orderId
+//
+// 返回值:
+//   - []model.Fill: 成交明细列表，按成交ID升序
+//   - []byte: 最后一次请求的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 传入fromId时从该成交ID开始自动翻页直到最新成交，fromId不能与startTime、endTime同时使用
+//   - 传入startTime时按7天窗口切分[startTime, endTime]，窗口内按时间自动翻页，endTime默认为当前时间
+//   - 都不传时只请求一次，返回最近7天内的成交
+//
+// 使用示例:
+//
+//	fills, _, err := prvApi.GetFills(pair, model.OptionParameter{Key: "fromId", Value: "0"})
+func (p *Prv) GetFills(pair model.CurrencyPair, opts ...model.OptionParameter) ([]model.Fill, []byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("limit", fmt.Sprint(fillPageSize))
+
+	util.MergeOptionParams(param, opts...)
+
+	return common.PageFills(param, fillWindow, p.getFillsPage)
+}
+
+func (p *Prv) getFillsPage(param *url.Values) ([]model.Fill, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetFillsUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fills, err := p.UnmarshalOpts.GetFillsResponseUnmarshaler(data)
+	return fills, data, err
+}
@@ -0,0 +1,95 @@
+package fapi
+
+import (
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"github.com/spf13/cast"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const (
+	incomePageSize = 1000                            //资金流水接口单次最多返回1000条
+	incomeWindow   = int64(7 * 24 * time.Hour / 1e6) //资金流水接口单次查询的最大时间跨度(毫秒)
+)
+
+// GetIncomeHistory 获取资金流水
+// 参数:
+//   - incomeType: 流水类型，如model.IncomeType_FundingFee，为空时返回所有类型
+//   - opts: 可选参数，支持symbol、startTime、endTime(毫秒时间戳)
+//
+// 返回值:
+//   - []model.Income: 资金流水列表，按时间升序
+//   - []byte: 最后一次请求的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 未传入startTime时只请求一次，币安返回最近7天的数据
+//   - 传入startTime时按7天窗口切分[startTime, endTime]，窗口内按时间自动翻页，endTime默认为当前时间
+//   - 翻页边界上的重复流水按incomeType+tranId去重
+//
+// 使用示例:
+//
+//	fees, _, err := prvApi.GetIncomeHistory(model.IncomeType_FundingFee,
+//	  model.OptionParameter{Key: "startTime", Value: fmt.Sprint(start.UnixMilli())})
+func (p *Prv) GetIncomeHistory(incomeType model.IncomeType, opts ...model.OptionParameter) (incomes []model.Income, responseBody []byte, err error) {
+	param := &url.Values{}
+	if incomeType != "" {
+		param.Set("incomeType", string(incomeType))
+	}
+	param.Set("limit", fmt.Sprint(incomePageSize))
+
+	util.MergeOptionParams(param, opts...)
+
+	if param.Get("startTime") == "" {
+		return p.getIncomeHistoryPage(param)
+	}
+
+	var (
+		limit     = cast.ToInt(param.Get("limit"))
+		startTime = cast.ToInt64(param.Get("startTime"))
+		endTime   = cast.ToInt64(param.Get("endTime"))
+		seen      = make(map[string]struct{}, incomePageSize)
+	)
+
+	if endTime == 0 {
+		endTime = time.Now().UnixMilli()
+	}
+
+	err = common.PageByTime(startTime, endTime, incomeWindow, limit, func(start, end int64) (int, int64, error) {
+		param.Set("startTime", fmt.Sprint(start))
+		param.Set("endTime", fmt.Sprint(end))
+
+		page, body, err := p.getIncomeHistoryPage(param)
+		responseBody = body
+		if err != nil || len(page) == 0 {
+			return 0, 0, err
+		}
+
+		for _, income := range page {
+			k := string(income.IncomeType) + income.TranId
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			incomes = append(incomes, income)
+		}
+
+		return len(page), page[len(page)-1].Time, nil
+	})
+
+	return incomes, responseBody, err
+}
+
+func (p *Prv) getIncomeHistoryPage(param *url.Values) ([]model.Income, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetIncomeHistoryUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	incomes, err := p.UnmarshalOpts.GetIncomeHistoryResponseUnmarshaler(data)
+	return incomes, data, err
+}
@@ -0,0 +1,214 @@
+package fapi
+
+import (
+	"errors"
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// stubHttpClient 替换httpcli.Cli，按请求返回预设的响应，并记录请求的URL
+type stubHttpClient struct {
+	mu       sync.Mutex
+	requests []string
+	handler  func(method, reqUrl string) ([]byte, error)
+}
+
+func (c *stubHttpClient) SetTimeout(sec int64)         {}
+func (c *stubHttpClient) SetProxy(proxy string) error  { return nil }
+func (c *stubHttpClient) SetHeaders(key, value string) {}
+func (c *stubHttpClient) DoRequest(method, rqUrl string, reqBody string, headers map[string]string) ([]byte, error) {
+	c.mu.Lock()
+	c.requests = append(c.requests, method+" "+rqUrl)
+	c.mu.Unlock()
+	return c.handler(method, rqUrl)
+}
+
+// withStubHttpClient 在测试期间使用stub替换httpcli.Cli，测试结束后恢复
+func withStubHttpClient(t *testing.T, handler func(method, reqUrl string) ([]byte, error)) *stubHttpClient {
+	t.Helper()
+	cli := &stubHttpClient{handler: handler}
+	old := httpcli.Cli
+	httpcli.Cli = cli
+	t.Cleanup(func() { httpcli.Cli = old })
+	return cli
+}
+
+// queryParams 解析请求URL中的参数
+func queryParams(t *testing.T, reqUrl string) url.Values {
+	t.Helper()
+	u, err := url.Parse(reqUrl)
+	if err != nil {
+		t.Fatalf("parse url %s error: %v", reqUrl, err)
+	}
+	return u.Query()
+}
+
+func newTestPrv() *Prv {
+	return NewPrvApi(NewFApi(), options.WithApiKey("key"), options.WithApiSecretKey("secret"))
+}
+
+func TestBuildCreateOrderParams(t *testing.T) {
+	pair := model.CurrencyPair{Symbol: "BTCUSDT", PricePrecision: 1, QtyPrecision: 3}
+
+	tests := []struct {
+		mode             string
+		side             model.OrderSide
+		opts             []model.OptionParameter
+		wantSide         string
+		wantPositionSide string
+		wantReduceOnly   string
+		wantQty          string
+	}{
+		{mode: model.ONE_WAY_POSITION_MODE, side: model.Futures_OpenBuy, wantSide: "BUY", wantQty: "0.01"},
+		{mode: model.ONE_WAY_POSITION_MODE, side: model.Futures_OpenSell, wantSide: "SELL", wantQty: "0.01"},
+		{mode: model.ONE_WAY_POSITION_MODE, side: model.Futures_CloseBuy, wantSide: "SELL", wantReduceOnly: "true", wantQty: "0.01"},
+		{mode: model.ONE_WAY_POSITION_MODE, side: model.Futures_CloseSell, wantSide: "BUY", wantReduceOnly: "true", wantQty: "0.01"},
+		{mode: model.TWO_WAY_POSITION_MODE, side: model.Futures_OpenBuy, wantSide: "BUY", wantPositionSide: "LONG", wantQty: "0.01"},
+		{mode: model.TWO_WAY_POSITION_MODE, side: model.Futures_OpenSell, wantSide: "SELL", wantPositionSide: "SHORT", wantQty: "0.01"},
+		{mode: model.TWO_WAY_POSITION_MODE, side: model.Futures_CloseBuy, wantSide: "SELL", wantPositionSide: "LONG", wantQty: "0.01"},
+		{mode: model.TWO_WAY_POSITION_MODE, side: model.Futures_CloseSell, wantSide: "BUY", wantPositionSide: "SHORT", wantQty: "0.01"},
+		{
+			mode:     model.ONE_WAY_POSITION_MODE,
+			side:     model.Futures_CloseBuy,
+			opts:     []model.OptionParameter{{Key: model.Close_Position__Opt_Key, Value: "true"}},
+			wantSide: "SELL",
+		},
+		{
+			mode:             model.TWO_WAY_POSITION_MODE,
+			side:             model.Futures_CloseSell,
+			opts:             []model.OptionParameter{{Key: model.Close_Position__Opt_Key, Value: "true"}},
+			wantSide:         "BUY",
+			wantPositionSide: "SHORT",
+		},
+	}
+
+	for _, tt := range tests {
+		param, err := BuildCreateOrderParams(pair, 0.01, 30000, tt.side, model.OrderType_Limit, tt.mode, tt.opts...)
+		if err != nil {
+			t.Errorf("%s %s: BuildCreateOrderParams() error = %v", tt.mode, tt.side, err)
+			continue
+		}
+
+		got := map[string]string{
+			"side":         param.Get("side"),
+			"positionSide": param.Get("positionSide"),
+			"reduceOnly":   param.Get("reduceOnly"),
+			"quantity":     param.Get("quantity"),
+		}
+		want := map[string]string{
+			"side":         tt.wantSide,
+			"positionSide": tt.wantPositionSide,
+			"reduceOnly":   tt.wantReduceOnly,
+			"quantity":     tt.wantQty,
+		}
+		for k := range want {
+			if got[k] != want[k] {
+				t.Errorf("%s %s %v: %s = %q, want %q", tt.mode, tt.side, tt.opts, k, got[k], want[k])
+			}
+		}
+		if param.Get("price") != "30000" || param.Get("timeInForce") != "GTC" {
+			t.Errorf("%s %s: price = %q, timeInForce = %q", tt.mode, tt.side, param.Get("price"), param.Get("timeInForce"))
+		}
+	}
+}
+
+func TestPositionModeCacheLoad(t *testing.T) {
+	var (
+		cache   PositionModeCache
+		calls   int
+		errDown = errors.New("network down")
+	)
+
+	failing := func(opts ...model.OptionParameter) (string, []byte, error) {
+		calls++
+		return "", nil, errDown
+	}
+	if _, err := cache.Load(failing); !errors.Is(err, errDown) {
+		t.Fatalf("Load() error = %v, want %v", err, errDown)
+	}
+	if _, err := cache.Load(failing); !errors.Is(err, errDown) || calls != 2 {
+		t.Fatalf("Load() after failure error = %v, calls = %d, want a new query", err, calls)
+	}
+
+	oneWay := func(opts ...model.OptionParameter) (string, []byte, error) {
+		calls++
+		return model.ONE_WAY_POSITION_MODE, nil, nil
+	}
+	for i := 0; i < 2; i++ {
+		mode, err := cache.Load(oneWay)
+		if err != nil || mode != model.ONE_WAY_POSITION_MODE {
+			t.Fatalf("Load() = %q, %v, want %q", mode, err, model.ONE_WAY_POSITION_MODE)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("query called %d times, want 3", calls)
+	}
+
+	cache.Set(model.TWO_WAY_POSITION_MODE)
+	if mode, _ := cache.Load(failing); mode != model.TWO_WAY_POSITION_MODE {
+		t.Errorf("Load() after Set = %q, want %q", mode, model.TWO_WAY_POSITION_MODE)
+	}
+}
+
+func TestSetPositionModeUpdatesCache(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     string
+		body     string
+		httpErr  error
+		wantErr  bool
+		wantMode string //为空表示缓存未更新
+	}{
+		{name: "success", mode: model.TWO_WAY_POSITION_MODE, body: `{"code":200,"msg":"success"}`, wantMode: model.TWO_WAY_POSITION_MODE},
+		{name: "already in mode", mode: model.ONE_WAY_POSITION_MODE, body: `{"code":-4059,"msg":"No need to change position side."}`, httpErr: errors.New("400 Bad Request"), wantMode: model.ONE_WAY_POSITION_MODE},
+		{name: "open positions", mode: model.ONE_WAY_POSITION_MODE, body: `{"code":-4068,"msg":"Position side cannot be changed if there exists position."}`, httpErr: errors.New("400 Bad Request"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+				return []byte(tt.body), tt.httpErr
+			})
+
+			prv := newTestPrv()
+			_, err := prv.SetPositionMode(tt.mode)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SetPositionMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			mode, err := prv.positionMode.Load(func(opts ...model.OptionParameter) (string, []byte, error) {
+				return "", nil, errors.New("not cached")
+			})
+			if tt.wantMode == "" {
+				if err == nil {
+					t.Errorf("cached mode = %q, want not cached", mode)
+				}
+			} else if mode != tt.wantMode {
+				t.Errorf("cached mode = %q, err = %v, want %q", mode, err, tt.wantMode)
+			}
+		})
+	}
+}
+
+func TestCreateOrderPositionModeError(t *testing.T) {
+	cli := withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		return []byte(`{"code":-2015,"msg":"Invalid API-key, IP, or permissions for action."}`), errors.New("401 Unauthorized")
+	})
+
+	prv := newTestPrv()
+	_, _, err := prv.CreateOrder(model.CurrencyPair{Symbol: "BTCUSDT"}, 0.01, 30000, model.Futures_CloseBuy, model.OrderType_Limit)
+	if err == nil || !strings.Contains(err.Error(), "get position mode error") {
+		t.Fatalf("CreateOrder() error = %v, want position mode error", err)
+	}
+
+	for _, req := range cli.requests {
+		if strings.HasPrefix(req, "POST ") {
+			t.Errorf("order sent without a known position mode: %s", req)
+		}
+	}
+}
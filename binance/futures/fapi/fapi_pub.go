@@ -1,15 +1,16 @@
 package fapi
 
 import (
-	"errors"
 	"fmt"
 	"github.com/nntaoli-project/goex/v2/binance/common"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
 	"github.com/nntaoli-project/goex/v2/logger"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/util"
+	"github.com/spf13/cast"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // DoNoAuthRequest 执行不需要认证的HTTP请求
@@ -49,14 +50,19 @@ func (f *FApi) GetName() string {
 
 // GetExchangeInfo 获取交易所支持的所有期货交易对信息
 // 返回值:
-//   - map[string]model.CurrencyPair: 交易对信息映射，key为交易对的symbol，value为交易对的详细信息
+//   - map[string]model.CurrencyPair: 交易对信息映射，key为BaseSymbol+QuoteSymbol+ContractAlias，如BTCUSDTPERPETUAL，value为交易对的详细信息
 //   - []byte: 原始响应数据
 //   - error: 错误信息
 //
 // 注意:
-//   - 在使用其他API前，建议先调用此方法获取交易对信息
-//   - 返回的交易对信息包含价格精度、数量精度、最小交易量等重要信息
+//   - 每次调用都会重新下载并更新SymbolRegistry，NewCurrencyPair会在首次使用时自动加载，无需先调用此方法
+//   - 返回的交易对信息包含价格精度、数量精度、最小交易量等重要信息，调用方不要修改返回的map
 func (f *FApi) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	return f.symbolRegistry.Refresh()
+}
+
+// fetchExchangeInfo 下载并解析交易所信息，作为SymbolRegistry的加载方法
+func (f *FApi) fetchExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
 	data, body, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.GetExchangeInfoUri, &url.Values{})
 	if err != nil {
 		logger.Errorf("[GetExchangeInfo] http request error, body: %s", string(body))
@@ -69,8 +75,6 @@ func (f *FApi) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error)
 		return nil, body, err
 	}
 
-	f.currencyPairM = m
-
 	return m, body, err
 }
 
@@ -78,34 +82,31 @@ func (f *FApi) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error)
 // 参数:
 //   - baseSym: 基础货币符号，如BTC
 //   - quoteSym: 计价货币符号，如USDT
-//   - opts: 可选参数，可以指定合约类型
+//   - opts: 可选参数，通过model.Contract_Alias__Opt_Key指定合约类型
 //
 // 返回值:
 //   - model.CurrencyPair: 交易对信息，包含Symbol、BaseCurrency、QuoteCurrency等
 //   - error: 错误信息，如果交易对不存在则返回错误
 //
 // 注意:
-//   - 使用此方法前必须先调用GetExchangeInfo方法
+//   - 首次调用时自动加载交易所信息，之后超过有效期会在后台刷新
 //   - 默认创建永续合约(PERPETUAL)交易对
-//   - 可以通过opts参数指定合约类型，如"contractAlias":"PERPETUAL"表示永续合约
+//   - 交割合约传入model.THIS_QUARTER_CONTRACT或model.NEXT_QUARTER_CONTRACT，也兼容币安的CURRENT_QUARTER、NEXT_QUARTER
+//   - 交割合约到期后会重新获取交易对信息，返回alias对应的新合约；获取失败时当季合约顺延到缓存中的次季合约
+//
+// 使用示例:
+//
+//	pair, err := fApi.NewCurrencyPair(model.BTC, model.USDT,
+//	  model.OptionParameter{Key: model.Contract_Alias__Opt_Key, Value: model.THIS_QUARTER_CONTRACT})
 func (f *FApi) NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error) {
-	var (
-		contractAlias string
-		currencyPair  model.CurrencyPair
-	)
-
-	if len(opts) == 0 {
-		contractAlias = "PERPETUAL"
-	} else if opts[0].Key == "contractAlias" {
-		contractAlias = opts[0].Value
-	}
-
-	currencyPair = f.currencyPairM[baseSym+quoteSym+contractAlias]
-	if currencyPair.Symbol == "" {
-		return currencyPair, errors.New("not found currency pair")
+	contractAlias := model.PERPETUAL_CONTRACT
+	for _, opt := range opts {
+		if opt.Key == model.Contract_Alias__Opt_Key {
+			contractAlias = common.AdaptContractTypeToAlias(opt.Value)
+		}
 	}
 
-	return currencyPair, nil
+	return common.ResolveContract(f.symbolRegistry, baseSym, quoteSym, contractAlias, time.Now())
 }
 
 // GetDepth 获取期货币对的深度数据
@@ -147,14 +148,167 @@ func (f *FApi) GetDepth(pair model.CurrencyPair, limit int, opt ...model.OptionP
 //
 // 返回值:
 //   - *model.Ticker: 行情数据，包含最新价、买一价、卖一价、24小时最高价、24小时最低价、24小时成交量等信息
-//   - []byte: 原始响应数据
+//   - []byte: 24hr行情接口的原始响应数据
 //   - error: 错误信息
 //
 // 注意:
-//   - 此方法尚未实现，调用会抛出panic异常
+//   - 24小时行情来自/fapi/v1/ticker/24hr，买一卖一价来自/fapi/v1/ticker/bookTicker
 func (f *FApi) GetTicker(pair model.CurrencyPair, opt ...model.OptionParameter) (ticker *model.Ticker, responseBody []byte, err error) {
-	//TODO implement me
-	panic("implement me")
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.TickerUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ticker, err = f.UnmarshalOpts.TickerUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	bookData, _, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.BookTickerUri, &params)
+	if err != nil {
+		return nil, responseBody, fmt.Errorf("get book ticker error: %w, body: %s", err, string(bookData))
+	}
+
+	book, err := f.UnmarshalOpts.TickerUnmarshaler(bookData)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ticker.Pair = pair
+	ticker.Buy = book.Buy
+	ticker.Sell = book.Sell
+
+	return ticker, responseBody, nil
+}
+
+// GetAllTickers 一次性获取所有永续合约的行情数据
+// 参数:
+//   - opt: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - map[string]*model.Ticker: 行情数据映射，键为交易对symbol
+//   - []byte: 24hr行情接口的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 只返回永续合约并填充完整的交易对信息，交易对信息未加载时会先自动加载
+//   - 买一卖一价来自/fapi/v1/ticker/bookTicker
+func (f *FApi) GetAllTickers(opt ...model.OptionParameter) (tickers map[string]*model.Ticker, responseBody []byte, err error) {
+	tickers, responseBody, err = f.getAllTickers(opt...)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	currencyPairM, err := f.symbolRegistry.Load()
+	if err != nil {
+		return nil, responseBody, fmt.Errorf("load exchange info error: %w", err)
+	}
+
+	perpetuals := make(map[string]model.CurrencyPair, len(currencyPairM))
+	for _, pair := range currencyPairM {
+		if pair.ContractAlias == model.PERPETUAL_CONTRACT {
+			perpetuals[pair.Symbol] = pair
+		}
+	}
+
+	for sym, tk := range tickers {
+		pair, ok := perpetuals[sym]
+		if !ok {
+			delete(tickers, sym)
+			continue
+		}
+		tk.Pair = pair
+	}
+
+	return tickers, responseBody, nil
+}
+
+// GetTickers 批量获取多个交易对的行情数据
+// 参数:
+//   - pairs: 交易对列表，为空时返回所有交易对(包括交割合约)
+//
+// 返回值:
+//   - map[string]*model.Ticker: 行情数据映射，键为交易对symbol
+//   - []byte: 24hr行情接口的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 合约行情接口不支持按多个symbol查询，固定请求两次全量接口(24hr和bookTicker)后按pairs过滤
+//   - pairs中不存在的交易对不会出现在结果中
+//   - pairs为空时填充完整的交易对信息，交易对信息未加载时会先自动加载
+func (f *FApi) GetTickers(pairs ...model.CurrencyPair) (map[string]*model.Ticker, []byte, error) {
+	tickers, responseBody, err := f.getAllTickers()
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if len(pairs) == 0 {
+		currencyPairM, err := f.symbolRegistry.Load()
+		if err != nil {
+			return nil, responseBody, fmt.Errorf("load exchange info error: %w", err)
+		}
+		bySymbol := make(map[string]model.CurrencyPair, len(currencyPairM))
+		for _, pair := range currencyPairM {
+			bySymbol[pair.Symbol] = pair
+		}
+		for sym, tk := range tickers {
+			if pair, ok := bySymbol[sym]; ok {
+				tk.Pair = pair
+			}
+		}
+		return tickers, responseBody, nil
+	}
+
+	result := make(map[string]*model.Ticker, len(pairs))
+	for _, pair := range pairs {
+		if tk, ok := tickers[pair.Symbol]; ok {
+			tk.Pair = pair
+			result[pair.Symbol] = tk
+		}
+	}
+
+	return result, responseBody, nil
+}
+
+// getAllTickers 获取全部交易对的24hr行情，并合并bookTicker中的买一卖一价
+func (f *FApi) getAllTickers(opt ...model.OptionParameter) (map[string]*model.Ticker, []byte, error) {
+	params := url.Values{}
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.TickerUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	tickers, err := f.UnmarshalOpts.TickersUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	bookData, _, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.BookTickerUri, &params)
+	if err != nil {
+		return nil, responseBody, fmt.Errorf("get book tickers error: %w, body: %s", err, string(bookData))
+	}
+
+	books, err := f.UnmarshalOpts.TickersUnmarshaler(bookData)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	for sym, tk := range tickers {
+		if book, ok := books[sym]; ok {
+			tk.Buy = book.Buy
+			tk.Sell = book.Sell
+		}
+	}
+
+	return tickers, responseBody, nil
 }
 
 // GetKline 获取期货K线数据
@@ -191,3 +345,154 @@ func (f *FApi) GetKline(pair model.CurrencyPair, period model.KlinePeriod, opt .
 
 	return klines, responseBody, err
 }
+
+// klineRangeLimit 翻页获取K线时每页的条数，1000条以内的请求权重为5
+const klineRangeLimit = 1000
+
+// GetKlineRange 获取[start, end]区间内的全部K线，自动翻页并去重
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - start: 开始时间(毫秒)，包含
+//   - end: 结束时间(毫秒)，包含
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.Kline: 按时间升序排列的K线
+//   - []model.KlineGap: 缺失的K线区间
+//   - error: 错误信息，出错时返回已获取的K线
+//
+// 注意:
+//   - 数据量较大(如多年的分钟线)时请使用StreamKlineRange，避免全部K线驻留内存
+func (f *FApi) GetKlineRange(pair model.CurrencyPair, period model.KlinePeriod, start, end int64, opts ...model.OptionParameter) ([]model.Kline, []model.KlineGap, error) {
+	var klines []model.Kline
+	gaps, err := f.StreamKlineRange(pair, period, start, end, func(page []model.Kline) error {
+		klines = append(klines, page...)
+		return nil
+	}, opts...)
+	return klines, gaps, err
+}
+
+// StreamKlineRange 按页获取[start, end]区间内的K线，每页去重后回调handler
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - start: 开始时间(毫秒)，包含
+//   - end: 结束时间(毫秒)，包含
+//   - handler: 每页K线的回调，按时间升序，返回错误时停止获取
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.KlineGap: 相邻K线之间缺失的区间，月线不检测
+//   - error: 请求或handler返回的错误
+func (f *FApi) StreamKlineRange(pair model.CurrencyPair, period model.KlinePeriod, start, end int64, handler func([]model.Kline) error, opts ...model.OptionParameter) ([]model.KlineGap, error) {
+	interval := common.KlineIntervalMillis(common.AdaptKlinePeriodToSymbol(period))
+	return common.StreamKlineRange(start, end, interval, klineRangeLimit, func(start, end int64) ([]model.Kline, error) {
+		pageOpts := append([]model.OptionParameter{}, opts...)
+		pageOpts = append(pageOpts,
+			model.OptionParameter{Key: "startTime", Value: fmt.Sprint(start)},
+			model.OptionParameter{Key: "endTime", Value: fmt.Sprint(end)},
+			model.OptionParameter{Key: "limit", Value: fmt.Sprint(klineRangeLimit)})
+
+		klines, _, err := f.GetKline(pair, period, pageOpts...)
+		return klines, err
+	}, handler)
+}
+
+// GetFundingRate 获取永续合约当前资金费率
+// 参数:
+//   - pair: 交易对信息
+//   - opts: 可选参数，可以传递额外的请求参数
+//
+// 返回值:
+//   - *model.FundingRate: 资金费率信息，包含最近资金费率、下次资金费时间、标记价格和指数价格
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (f *FApi) GetFundingRate(pair model.CurrencyPair, opts ...model.OptionParameter) (rate *model.FundingRate, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(&params, opts...)
+
+	data, responseBody, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.GetFundingRateUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	rate, err = f.UnmarshalOpts.GetFundingRateResponseUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	if rate.Symbol == "" {
+		rate.Symbol = pair.Symbol
+	}
+
+	return rate, responseBody, nil
+}
+
+// GetFundingRateHistory 获取永续合约历史资金费率
+// 参数:
+//   - pair: 交易对信息
+//   - limit: 返回的最大记录数
+//   - opts: 可选参数，支持startTime、endTime(毫秒时间戳)
+//
+// 返回值:
+//   - []model.FundingRate: 历史资金费率列表，按资金费收取时间升序
+//   - []byte: 最后一次请求的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 币安单次最多返回1000条记录
+//   - 传入startTime时会从startTime开始自动翻页，直到达到limit或endTime
+//   - 未传入startTime时只请求一次，返回最近的limit条记录
+//   - limit<=0时使用币安默认值100
+func (f *FApi) GetFundingRateHistory(pair model.CurrencyPair, limit int, opts ...model.OptionParameter) (rates []model.FundingRate, responseBody []byte, err error) {
+	const pageSize = 1000
+
+	if limit <= 0 {
+		limit = 100 //币安默认值
+	}
+
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(min(limit, pageSize)))
+
+	util.MergeOptionParams(&params, opts...)
+
+	paging := params.Get("startTime") != ""
+	endTime := cast.ToInt64(params.Get("endTime"))
+
+	for {
+		data, body, err := f.DoNoAuthRequest(http.MethodGet, f.UriOpts.Endpoint+f.UriOpts.GetFundingRateHistoryUri, &params)
+		responseBody = body
+		if err != nil {
+			return rates, responseBody, err
+		}
+
+		page, err := f.UnmarshalOpts.GetFundingRateHistoryResponseUnmarshaler(data)
+		if err != nil {
+			return rates, responseBody, err
+		}
+
+		rates = append(rates, page...)
+
+		if !paging || len(page) < cast.ToInt(params.Get("limit")) || len(rates) >= limit {
+			break
+		}
+
+		nextStartTime := page[len(page)-1].Tm + 1
+		if endTime > 0 && nextStartTime > endTime {
+			break
+		}
+
+		params.Set("startTime", fmt.Sprint(nextStartTime))
+		params.Set("limit", fmt.Sprint(min(limit-len(rates), pageSize)))
+	}
+
+	if len(rates) > limit {
+		rates = rates[:limit]
+	}
+
+	return rates, responseBody, nil
+}
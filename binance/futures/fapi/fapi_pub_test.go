@@ -0,0 +1,275 @@
+package fapi
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"strings"
+	"testing"
+)
+
+// 交割时间使用远离当前时间的固定值，测试结果不依赖运行时间
+const (
+	deliveredAt = 946713600000  //2000-01-01 08:00 UTC，已交割
+	deliverAt1  = 4102473600000 //2100-01-01 08:00 UTC
+	deliverAt2  = 4110249600000 //2100-04-01 08:00 UTC
+)
+
+func exchangeInfoSymbol(symbol, contractType, status string, deliveryDate int64) string {
+	return fmt.Sprintf(`{"symbol":"%s","pair":"BTCUSDT","contractType":"%s","deliveryDate":%d,"status":"%s","baseAsset":"BTC","quoteAsset":"USDT","pricePrecision":2,"quantityPrecision":3,"filters":[]}`,
+		symbol, contractType, deliveryDate, status)
+}
+
+func exchangeInfoFixture(symbols ...string) []byte {
+	return []byte(`{"timezone":"UTC","symbols":[` + strings.Join(symbols, ",") + `]}`)
+}
+
+func TestNewCurrencyPairContractAlias(t *testing.T) {
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		return exchangeInfoFixture(
+			exchangeInfoSymbol("BTCUSDT", "PERPETUAL", "TRADING", 4133404800000),
+			exchangeInfoSymbol("BTCUSDT_991231", "CURRENT_QUARTER", "TRADING", deliverAt1),
+			exchangeInfoSymbol("BTCUSDT_000101", "CURRENT_QUARTER", "SETTLING", deliveredAt), //交割中的旧合约不覆盖交易中的合约
+			exchangeInfoSymbol("BTCUSDT_000331", "NEXT_QUARTER", "TRADING", deliverAt2),
+		), nil
+	})
+
+	fApi := NewFApi()
+
+	tests := []struct {
+		alias      string
+		wantSymbol string
+		wantAlias  string
+	}{
+		{alias: "", wantSymbol: "BTCUSDT", wantAlias: model.PERPETUAL_CONTRACT},
+		{alias: "PERPETUAL", wantSymbol: "BTCUSDT", wantAlias: model.PERPETUAL_CONTRACT},
+		{alias: model.THIS_QUARTER_CONTRACT, wantSymbol: "BTCUSDT_991231", wantAlias: model.THIS_QUARTER_CONTRACT},
+		{alias: "CURRENT_QUARTER", wantSymbol: "BTCUSDT_991231", wantAlias: model.THIS_QUARTER_CONTRACT},
+		{alias: "QUARTER", wantSymbol: "BTCUSDT_991231", wantAlias: model.THIS_QUARTER_CONTRACT},
+		{alias: model.NEXT_QUARTER_CONTRACT, wantSymbol: "BTCUSDT_000331", wantAlias: model.NEXT_QUARTER_CONTRACT},
+		{alias: "NEXT_QUARTER", wantSymbol: "BTCUSDT_000331", wantAlias: model.NEXT_QUARTER_CONTRACT},
+	}
+
+	for _, tt := range tests {
+		var opts []model.OptionParameter
+		if tt.alias != "" {
+			opts = append(opts, model.OptionParameter{Key: model.Contract_Alias__Opt_Key, Value: tt.alias})
+		}
+
+		pair, err := fApi.NewCurrencyPair(model.BTC, model.USDT, opts...)
+		if err != nil {
+			t.Errorf("NewCurrencyPair(%q) error = %v", tt.alias, err)
+			continue
+		}
+		if pair.Symbol != tt.wantSymbol || pair.ContractAlias != tt.wantAlias {
+			t.Errorf("NewCurrencyPair(%q) = %s(%s), want %s(%s)", tt.alias, pair.Symbol, pair.ContractAlias, tt.wantSymbol, tt.wantAlias)
+		}
+	}
+
+	if _, err := fApi.NewCurrencyPair("ETH", model.USDT); err == nil {
+		t.Errorf("NewCurrencyPair(ETH) found a pair missing from exchange info")
+	}
+}
+
+func TestNewCurrencyPairRollsAfterDelivery(t *testing.T) {
+	//缓存中的当季合约已交割，刷新后交易所返回新的当季合约
+	stale := exchangeInfoFixture(
+		exchangeInfoSymbol("BTCUSDT_000101", "CURRENT_QUARTER", "TRADING", deliveredAt),
+		exchangeInfoSymbol("BTCUSDT_991231", "NEXT_QUARTER", "TRADING", deliverAt1),
+	)
+	fresh := exchangeInfoFixture(
+		exchangeInfoSymbol("BTCUSDT_991231", "CURRENT_QUARTER", "TRADING", deliverAt1),
+		exchangeInfoSymbol("BTCUSDT_000331", "NEXT_QUARTER", "TRADING", deliverAt2),
+	)
+
+	tests := []struct {
+		name       string
+		refresh    func() ([]byte, error)
+		alias      string
+		wantSymbol string
+		wantErr    bool
+		wantLoads  int
+	}{
+		{
+			name:       "refreshed after delivery",
+			refresh:    func() ([]byte, error) { return fresh, nil },
+			alias:      model.THIS_QUARTER_CONTRACT,
+			wantSymbol: "BTCUSDT_991231",
+			wantLoads:  2,
+		},
+		{
+			name:       "rolls to cached next quarter when refresh fails",
+			refresh:    func() ([]byte, error) { return nil, errors.New("network down") },
+			alias:      model.THIS_QUARTER_CONTRACT,
+			wantSymbol: "BTCUSDT_991231",
+			wantLoads:  2,
+		},
+		{
+			name:       "next quarter is not refreshed",
+			refresh:    func() ([]byte, error) { return fresh, nil },
+			alias:      model.NEXT_QUARTER_CONTRACT,
+			wantSymbol: "BTCUSDT_991231",
+			wantLoads:  1,
+		},
+		{
+			name:      "unknown alias",
+			refresh:   func() ([]byte, error) { return fresh, nil },
+			alias:     "BI_QUARTER",
+			wantErr:   true,
+			wantLoads: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loads := 0
+			withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+				loads++
+				if loads == 1 {
+					return stale, nil
+				}
+				return tt.refresh()
+			})
+
+			pair, err := NewFApi().NewCurrencyPair(model.BTC, model.USDT, model.OptionParameter{Key: model.Contract_Alias__Opt_Key, Value: tt.alias})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewCurrencyPair() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (pair.Symbol != tt.wantSymbol || pair.ContractAlias != tt.alias) {
+				t.Errorf("NewCurrencyPair() = %s(%s), want %s(%s)", pair.Symbol, pair.ContractAlias, tt.wantSymbol, tt.alias)
+			}
+			if loads != tt.wantLoads {
+				t.Errorf("exchange info requested %d times, want %d", loads, tt.wantLoads)
+			}
+		})
+	}
+}
+
+const fundingInterval = 8 * 60 * 60 * 1000
+
+// fundingRateServer 模拟币安历史资金费率接口，数据从startTime开始每8小时一条
+func fundingRateServer(t *testing.T, startTime int64, total int, failAt int) (handler func(method, reqUrl string) ([]byte, error), requests *[]string) {
+	var reqs []string
+	handler = func(method, reqUrl string) ([]byte, error) {
+		params := queryParams(t, reqUrl)
+		reqs = append(reqs, params.Encode())
+		if len(reqs) == failAt {
+			return nil, errors.New("network down")
+		}
+
+		var (
+			from  = cast.ToInt64(params.Get("startTime"))
+			to    = cast.ToInt64(params.Get("endTime"))
+			limit = cast.ToInt(params.Get("limit"))
+			items []string
+		)
+		if !params.Has("startTime") { //未传startTime时返回最近的limit条
+			from = startTime + int64(max(total-limit, 0))*fundingInterval
+		}
+		for i := 0; i < total && len(items) < limit; i++ {
+			tm := startTime + int64(i)*fundingInterval
+			if tm < from || to > 0 && tm > to {
+				continue
+			}
+			items = append(items, fmt.Sprintf(`{"symbol":"BTCUSDT","fundingTime":%d,"fundingRate":"0.0001","markPrice":"30000"}`, tm))
+		}
+		return []byte("[" + strings.Join(items, ",") + "]"), nil
+	}
+	return handler, &reqs
+}
+
+func TestGetFundingRateHistoryPaging(t *testing.T) {
+	const startTime = int64(1672531200000) //2023-01-01 00:00 UTC
+
+	tests := []struct {
+		name         string
+		total        int
+		limit        int
+		opts         []model.OptionParameter
+		failAt       int
+		wantN        int
+		wantRequests []string
+		wantErr      bool
+	}{
+		{
+			name:         "no startTime requests once",
+			total:        3000,
+			limit:        0,
+			wantN:        100,
+			wantRequests: []string{"limit=100&symbol=BTCUSDT"},
+		},
+		{
+			name:  "pages from startTime until limit",
+			total: 3000,
+			limit: 2500,
+			opts:  []model.OptionParameter{{Key: "startTime", Value: fmt.Sprint(startTime)}},
+			wantN: 2500,
+			wantRequests: []string{
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime),
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime+999*fundingInterval+1),
+				fmt.Sprintf("limit=500&startTime=%d&symbol=BTCUSDT", startTime+1999*fundingInterval+1),
+			},
+		},
+		{
+			name:  "stops on short page",
+			total: 1200,
+			limit: 5000,
+			opts:  []model.OptionParameter{{Key: "startTime", Value: fmt.Sprint(startTime)}},
+			wantN: 1200,
+			wantRequests: []string{
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime),
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime+999*fundingInterval+1),
+			},
+		},
+		{
+			name:  "stops at endTime without an extra request",
+			total: 3000,
+			limit: 5000,
+			opts: []model.OptionParameter{
+				{Key: "startTime", Value: fmt.Sprint(startTime)},
+				{Key: "endTime", Value: fmt.Sprint(startTime + 999*fundingInterval)},
+			},
+			wantN: 1000,
+			wantRequests: []string{
+				fmt.Sprintf("endTime=%d&limit=1000&startTime=%d&symbol=BTCUSDT", startTime+999*fundingInterval, startTime),
+			},
+		},
+		{
+			name:   "returns fetched pages on error",
+			total:  3000,
+			limit:  2500,
+			opts:   []model.OptionParameter{{Key: "startTime", Value: fmt.Sprint(startTime)}},
+			failAt: 2,
+			wantN:  1000,
+			wantRequests: []string{
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime),
+				fmt.Sprintf("limit=1000&startTime=%d&symbol=BTCUSDT", startTime+999*fundingInterval+1),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, requests := fundingRateServer(t, startTime, tt.total, tt.failAt)
+			withStubHttpClient(t, handler)
+
+			rates, _, err := NewFApi().GetFundingRateHistory(model.CurrencyPair{Symbol: "BTCUSDT"}, tt.limit, tt.opts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetFundingRateHistory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(rates) != tt.wantN {
+				t.Errorf("GetFundingRateHistory() returned %d rates, want %d", len(rates), tt.wantN)
+			}
+			for i := 1; i < len(rates); i++ {
+				if rates[i].Tm <= rates[i-1].Tm {
+					t.Fatalf("rates[%d].Tm = %d not after rates[%d].Tm = %d", i, rates[i].Tm, i-1, rates[i-1].Tm)
+				}
+			}
+			if strings.Join(*requests, "\n") != strings.Join(tt.wantRequests, "\n") {
+				t.Errorf("requests =\n%s\nwant\n%s", strings.Join(*requests, "\n"), strings.Join(tt.wantRequests, "\n"))
+			}
+		})
+	}
+}
@@ -20,15 +20,19 @@ import (
 //
 // 注意:
 //   - 此函数解析币安期货交易所信息，提取交易对详情、精度和交易限制
+//   - 键为BaseSymbol+QuoteSymbol+ContractAlias，contractType转换为合约alias，如BTCUSDTPERPETUAL、BTCUSDTthis_quarter
+//   - 同一alias存在多个合约时(如交割中的旧合约)优先保留交易中(TRADING)的合约
 func UnmarshalGetExchangeInfoResponse(data []byte) (map[string]model.CurrencyPair, error) {
 	var (
 		err             error
 		currencyPairMap = make(map[string]model.CurrencyPair, 40)
+		tradingKeys     = make(map[string]bool, 40)
 	)
 
 	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
 		var (
 			currencyPair model.CurrencyPair
+			status       string
 		)
 
 		currencyPair.ContractVal = 1
@@ -44,7 +48,9 @@ func UnmarshalGetExchangeInfoResponse(data []byte) (map[string]model.CurrencyPai
 			case "quoteAsset":
 				currencyPair.QuoteSymbol = valStr
 			case "contractType":
-				currencyPair.ContractAlias = valStr
+				currencyPair.ContractAlias = common.AdaptContractTypeToAlias(valStr)
+			case "status":
+				status = valStr
 			case "pricePrecision":
 				currencyPair.PricePrecision = cast.ToInt(valStr)
 			case "quantityPrecision":
@@ -52,39 +58,20 @@ func UnmarshalGetExchangeInfoResponse(data []byte) (map[string]model.CurrencyPai
 			case "deliveryDate":
 				currencyPair.ContractDeliveryDate = cast.ToInt64(valStr)
 			case "onboardDate":
-
+				currencyPair.ContractOnboardDate = cast.ToInt64(valStr)
 			case "filters":
-				_, err = jsonparser.ArrayEach(val, func(filterData []byte, dataType jsonparser.ValueType, offset int, err error) {
-					filterType, _ := jsonparser.GetString(filterData, "filterType")
-					if filterType == "LOT_SIZE" {
-						var (
-							minQty []byte
-							maxQty []byte
-						)
-
-						minQty, _, _, err = jsonparser.Get(filterData, "minQty")
-						maxQty, _, _, err = jsonparser.Get(filterData, "maxQty")
-
-						currencyPair.MinQty = cast.ToFloat64(string(minQty))
-						currencyPair.MaxQty = cast.ToFloat64(string(maxQty))
-					}
-
-					if filterType == "MARKET_LOT_SIZE" {
-
-					}
-
-					//if filterType == "MIN_NOTIONAL" {
-					//	currencyPair.MinQty, err = jsonparser.GetFloat(filterData, "notional")
-					//	if err != nil {
-					//		logger.Errorf("[UnmarshalGetExchangeInfoResponse] get notional error: %s", err.Error())
-					//	}
-					//}
-				})
+				currencyPair.Filters = common.UnmarshalSymbolFilters(val)
+				currencyPair.MinQty = currencyPair.Filters.MinQty
+				currencyPair.MaxQty = currencyPair.Filters.MaxQty
 			}
 			return err
 		})
 
 		k := fmt.Sprintf("%s%s%s", currencyPair.BaseSymbol, currencyPair.QuoteSymbol, currencyPair.ContractAlias)
+		if tradingKeys[k] && status != "TRADING" {
+			return
+		}
+		tradingKeys[k] = status == "TRADING"
 		currencyPairMap[k] = currencyPair
 
 	}, "symbols")
@@ -151,11 +138,97 @@ func unmarshalDepthItem(data []byte) (model.DepthItems, error) {
 			}
 			i += 1
 		})
+		if model.ExactDecimalEnabled() {
+			item.Exact = &model.DepthItemExact{
+				Price:  common.GetDecimal(asksItemData, "[0]"),
+				Amount: common.GetDecimal(asksItemData, "[1]"),
+			}
+		}
 		items = append(items, item)
 	})
 	return items, err
 }
 
+// UnmarshalTickerResponse 解析行情数据响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.Ticker: 行情数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 同时兼容/fapi/v1/ticker/24hr和/fapi/v1/ticker/bookTicker的返回字段
+//   - 24hr接口不包含买一卖一价，bookTicker接口只包含买一卖一价
+func UnmarshalTickerResponse(data []byte) (*model.Ticker, error) {
+	var tk model.Ticker
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "symbol":
+			tk.Pair.Symbol = valStr
+		case "lastPrice":
+			tk.Last = cast.ToFloat64(valStr)
+		case "highPrice":
+			tk.High = cast.ToFloat64(valStr)
+		case "lowPrice":
+			tk.Low = cast.ToFloat64(valStr)
+		case "volume":
+			tk.Vol = cast.ToFloat64(valStr)
+		case "priceChangePercent":
+			tk.Percent = cast.ToFloat64(valStr)
+		case "bidPrice":
+			tk.Buy = cast.ToFloat64(valStr)
+		case "askPrice":
+			tk.Sell = cast.ToFloat64(valStr)
+		case "closeTime", "time":
+			tk.Timestamp = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	if model.ExactDecimalEnabled() {
+		tk.Exact = &model.TickerExact{
+			Last: common.GetDecimal(data, "lastPrice"),
+			Buy:  common.GetDecimal(data, "bidPrice"),
+			Sell: common.GetDecimal(data, "askPrice"),
+			High: common.GetDecimal(data, "highPrice"),
+			Low:  common.GetDecimal(data, "lowPrice"),
+			Vol:  common.GetDecimal(data, "volume"),
+		}
+	}
+	return &tk, err
+}
+
+// UnmarshalTickersResponse 解析多个交易对的行情数据响应
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - map[string]*model.Ticker: 行情数据映射，键为交易对symbol
+//   - error: 错误信息，任一交易对解析失败时返回第一个错误
+func UnmarshalTickersResponse(data []byte) (map[string]*model.Ticker, error) {
+	var (
+		tickers = make(map[string]*model.Ticker, 200)
+		tkErr   error
+	)
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		if tkErr != nil {
+			return
+		}
+		tk, err := UnmarshalTickerResponse(value)
+		if err != nil {
+			tkErr = fmt.Errorf("unmarshal ticker error: %w", err)
+			return
+		}
+		tickers[tk.Pair.Symbol] = tk
+	})
+	if err == nil {
+		err = tkErr
+	}
+	return tickers, err
+}
+
 // UnmarshalKlinesResponse 解析K线数据响应
 // 参数:
 //   - data: API响应的原始数据
@@ -196,6 +269,16 @@ func UnmarshalKlinesResponse(data []byte) ([]model.Kline, error) {
 			i += 1
 		})
 
+		if model.ExactDecimalEnabled() {
+			k.Exact = &model.KlineExact{
+				Open:  common.GetDecimal(value, "[1]"),
+				High:  common.GetDecimal(value, "[2]"),
+				Low:   common.GetDecimal(value, "[3]"),
+				Close: common.GetDecimal(value, "[4]"),
+				Vol:   common.GetDecimal(value, "[5]"),
+			}
+		}
+
 		klines = append(klines, k)
 	})
 
@@ -233,6 +316,88 @@ func UnmarshalGetAccountResponse(data []byte) (map[string]model.Account, error)
 	return accounts, err
 }
 
+// UnmarshalGetFuturesAccountResponse 解析期货账户信息响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 期货账户信息映射，键为币种名称
+//   - error: 错误信息
+//
+// 注意:
+//   - 此函数解析/fapi/v2/account中的assets数组
+//   - Eq为保证金余额(钱包余额+未实现盈亏)，FrozenBal为起始保证金占用
+//   - MgnRatio为维持保证金/保证金余额，保证金余额为0时不计算
+func UnmarshalGetFuturesAccountResponse(data []byte) (map[string]model.FuturesAccount, error) {
+	var accounts = make(map[string]model.FuturesAccount, 4)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			acc         model.FuturesAccount
+			maintMargin float64
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "marginBalance":
+				acc.Eq = cast.ToFloat64(valStr)
+			case "availableBalance":
+				acc.AvailEq = cast.ToFloat64(valStr)
+			case "initialMargin":
+				acc.FrozenBal = cast.ToFloat64(valStr)
+			case "unrealizedProfit":
+				acc.Upl = cast.ToFloat64(valStr)
+			case "maintMargin":
+				maintMargin = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		if acc.Eq > 0 {
+			acc.MgnRatio = maintMargin / acc.Eq
+		}
+		accounts[acc.Coin] = acc
+	}, "assets")
+	return accounts, err
+}
+
+// UnmarshalGetMultiAssetsAccountResponse 解析期货账户汇总信息
+// 参数:
+//   - data: /fapi/v2/account的原始响应数据
+//
+// 返回值:
+//   - *model.FuturesAccount: 账户汇总信息，Coin固定为USD
+//   - error: 错误信息
+//
+// 注意:
+//   - 联合保证金模式下各total字段为所有资产折合USD的汇总值，单币保证金模式下为USDT资产的值
+func UnmarshalGetMultiAssetsAccountResponse(data []byte) (*model.FuturesAccount, error) {
+	var (
+		acc         = model.FuturesAccount{Coin: model.USD}
+		maintMargin float64
+	)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "totalMarginBalance":
+			acc.Eq = cast.ToFloat64(valStr)
+		case "availableBalance":
+			acc.AvailEq = cast.ToFloat64(valStr)
+		case "totalInitialMargin":
+			acc.FrozenBal = cast.ToFloat64(valStr)
+		case "totalUnrealizedProfit":
+			acc.Upl = cast.ToFloat64(valStr)
+		case "totalMaintMargin":
+			maintMargin = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+	if acc.Eq > 0 {
+		acc.MgnRatio = maintMargin / acc.Eq
+	}
+	return &acc, err
+}
+
 // UnmarshalCreateOrderResponse 解析创建订单响应数据
 // 参数:
 //   - data: API响应的原始数据
@@ -261,6 +426,12 @@ func UnmarshalCreateOrderResponse(data []byte) (*model.Order, error) {
 		}
 		return nil
 	})
+	if model.ExactDecimalEnabled() {
+		order.Exact = &model.OrderExact{
+			ExecutedQty: common.GetDecimal(data, "executedQty"),
+			PriceAvg:    common.GetDecimal(data, "avgPrice"),
+		}
+	}
 	return &order, err
 }
 
@@ -338,10 +509,13 @@ func UnmarshalGetHistoryOrdersResponse(data []byte) ([]model.Order, error) {
 // 注意:
 //   - 此函数解析币安期货订单数据，提取订单ID、价格、数量、状态、时间等信息
 //   - 根据side和positionSide确定订单方向(开多/开空/平多/平空)
+//   - 单向持仓模式(positionSide=BOTH)下根据side和reduceOnly确定订单方向
+//   - 条件单会解析触发价格、激活价格、回调幅度、触发价格类型、价格保护和全部平仓标记
 func UnmarshalOrderResponse(data []byte) (ord model.Order, err error) {
 	var (
 		positionSide string
 		side         string
+		reduceOnly   bool
 	)
 
 	err = jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
@@ -355,8 +529,10 @@ func UnmarshalOrderResponse(data []byte) (ord model.Order, err error) {
 			ord.Price = cast.ToFloat64(valStr)
 		case "origQty":
 			ord.Qty = cast.ToFloat64(valStr)
-		case "executeQty":
+		case "executedQty":
 			ord.ExecutedQty = cast.ToFloat64(valStr)
+		case "avgPrice":
+			ord.PriceAvg = cast.ToFloat64(valStr)
 		case "time":
 			ord.CreatedAt = cast.ToInt64(valStr)
 		case "updateTime":
@@ -367,8 +543,22 @@ func UnmarshalOrderResponse(data []byte) (ord model.Order, err error) {
 			side = valStr
 		case "positionSide":
 			positionSide = valStr
+		case "reduceOnly":
+			reduceOnly = cast.ToBool(valStr)
 		case "type":
 			ord.OrderTy = common.AdaptStringToOrderType(valStr)
+		case "stopPrice":
+			ord.StopPrice = cast.ToFloat64(valStr)
+		case "activatePrice":
+			ord.ActivationPrice = cast.ToFloat64(valStr)
+		case "priceRate":
+			ord.CallbackRate = cast.ToFloat64(valStr)
+		case "workingType":
+			ord.WorkingType = valStr
+		case "priceProtect":
+			ord.PriceProtect = cast.ToBool(valStr)
+		case "closePosition":
+			ord.ClosePosition = cast.ToBool(valStr)
 		}
 		return nil
 	})
@@ -377,7 +567,21 @@ func UnmarshalOrderResponse(data []byte) (ord model.Order, err error) {
 		ord.CanceledAt = ord.FinishedAt
 	}
 
-	ord.Side = common.AdaptStringToFuturesOrderSide(side, positionSide)
+	if model.ExactDecimalEnabled() {
+		ord.Exact = &model.OrderExact{
+			Price:       common.GetDecimal(data, "price"),
+			Qty:         common.GetDecimal(data, "origQty"),
+			ExecutedQty: common.GetDecimal(data, "executedQty"),
+			PriceAvg:    common.GetDecimal(data, "avgPrice"),
+			StopPrice:   common.GetDecimal(data, "stopPrice"),
+		}
+	}
+
+	if positionSide == "BOTH" {
+		ord.Side = common.AdaptOneWayStringToFuturesOrderSide(side, reduceOnly)
+	} else {
+		ord.Side = common.AdaptStringToFuturesOrderSide(side, positionSide)
+	}
 
 	return
 }
@@ -459,3 +663,299 @@ func UnmarshalGetPositionsResponse(data []byte) ([]model.FuturesPosition, error)
 	})
 	return positions, err
 }
+
+// UnmarshalGetFundingRateResponse 解析当前资金费率响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.FundingRate: 资金费率信息
+//   - error: 错误信息
+//
+// 注意:
+//   - 此函数解析/fapi/v1/premiumIndex返回的单个交易对数据，提取最近资金费率、下次资金费时间、标记价格和指数价格
+func UnmarshalGetFundingRateResponse(data []byte) (*model.FundingRate, error) {
+	var rate model.FundingRate
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "symbol":
+			rate.Symbol = valStr
+		case "lastFundingRate":
+			rate.Rate = cast.ToFloat64(valStr)
+		case "nextFundingTime":
+			rate.NextFundingTime = cast.ToInt64(valStr)
+		case "markPrice":
+			rate.MarkPrice = cast.ToFloat64(valStr)
+		case "indexPrice":
+			rate.IndexPrice = cast.ToFloat64(valStr)
+		case "time":
+			rate.Tm = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	return &rate, err
+}
+
+// UnmarshalGetFundingRateHistoryResponse 解析历史资金费率响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.FundingRate: 历史资金费率列表，按资金费收取时间升序
+//   - error: 错误信息
+func UnmarshalGetFundingRateHistoryResponse(data []byte) ([]model.FundingRate, error) {
+	var rates []model.FundingRate
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var rate model.FundingRate
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				rate.Symbol = valStr
+			case "fundingRate":
+				rate.Rate = cast.ToFloat64(valStr)
+			case "fundingTime":
+				rate.Tm = cast.ToInt64(valStr)
+			case "markPrice":
+				rate.MarkPrice = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		rates = append(rates, rate)
+	})
+	return rates, err
+}
+
+// UnmarshalCodeMsgResponse 解析只包含code/msg的通用响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - error: code不为0或200时返回整个响应作为错误信息
+func UnmarshalCodeMsgResponse(data []byte) error {
+	code, err := jsonparser.GetInt(data, "code")
+	if err != nil {
+		return nil //没有code字段
+	}
+	if code != 0 && code != 200 {
+		return errors.New(string(data))
+	}
+	return nil
+}
+
+// UnmarshalSetLeverageResponse 解析设置杠杆倍数响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - error: 错误信息
+func UnmarshalSetLeverageResponse(data []byte) error {
+	if _, err := jsonparser.GetInt(data, "leverage"); err != nil {
+		return errors.New(string(data))
+	}
+	return nil
+}
+
+// UnmarshalGetLeverageResponse 解析当前杠杆倍数响应数据
+// 参数:
+//   - data: /fapi/v2/positionRisk的原始响应数据
+//
+// 返回值:
+//   - string: 杠杆倍数
+//   - error: 错误信息
+//
+// 注意:
+//   - 双向持仓模式下同一交易对会返回多条持仓，它们的杠杆倍数相同，取第一条
+func UnmarshalGetLeverageResponse(data []byte) (string, error) {
+	lever, err := jsonparser.GetString(data, "[0]", "leverage")
+	if err != nil {
+		return "", fmt.Errorf("get leverage error: %w, body: %s", err, string(data))
+	}
+	return lever, nil
+}
+
+// UnmarshalGetLeverageBracketsResponse 解析杠杆分层标准响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.LeverageBracket: 杠杆分层列表
+//   - error: 错误信息
+//
+// 注意:
+//   - 传入symbol时币安可能返回对象或只含一个元素的数组，两种格式都支持
+func UnmarshalGetLeverageBracketsResponse(data []byte) ([]model.LeverageBracket, error) {
+	var (
+		brackets []model.LeverageBracket
+		path     = []string{"brackets"}
+	)
+
+	if len(data) > 0 && data[0] == '[' {
+		path = []string{"[0]", "brackets"}
+	}
+
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var b model.LeverageBracket
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "bracket":
+				b.Bracket = cast.ToInt(valStr)
+			case "initialLeverage":
+				b.InitialLeverage = cast.ToInt(valStr)
+			case "notionalCap":
+				b.NotionalCap = cast.ToFloat64(valStr)
+			case "notionalFloor":
+				b.NotionalFloor = cast.ToFloat64(valStr)
+			case "maintMarginRatio":
+				b.MaintMarginRatio = cast.ToFloat64(valStr)
+			case "cum":
+				b.Cum = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		brackets = append(brackets, b)
+	}, path...)
+
+	return brackets, err
+}
+
+// UnmarshalSetPositionModeResponse 解析设置持仓模式响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - string: 响应中的msg
+//   - error: 错误信息
+func UnmarshalSetPositionModeResponse(data []byte) (string, error) {
+	if err := UnmarshalCodeMsgResponse(data); err != nil {
+		return "", err
+	}
+	msg, _ := jsonparser.GetString(data, "msg")
+	return msg, nil
+}
+
+// UnmarshalGetPositionModeResponse 解析当前持仓模式响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - string: model.TWO_WAY_POSITION_MODE或model.ONE_WAY_POSITION_MODE
+//   - error: 错误信息
+func UnmarshalGetPositionModeResponse(data []byte) (string, error) {
+	dual, err := jsonparser.GetBoolean(data, "dualSidePosition")
+	if err != nil {
+		return "", fmt.Errorf("get dualSidePosition error: %w, body: %s", err, string(data))
+	}
+	if dual {
+		return model.TWO_WAY_POSITION_MODE, nil
+	}
+	return model.ONE_WAY_POSITION_MODE, nil
+}
+
+// UnmarshalGetIncomeHistoryResponse 解析资金流水响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.Income: 资金流水列表
+//   - error: 错误信息
+func UnmarshalGetIncomeHistoryResponse(data []byte) ([]model.Income, error) {
+	var incomes []model.Income
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var income model.Income
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				income.Symbol = valStr
+			case "incomeType":
+				income.IncomeType = model.IncomeType(valStr)
+			case "income":
+				income.Amount = cast.ToFloat64(valStr)
+			case "asset":
+				income.Asset = valStr
+			case "info":
+				income.Info = valStr
+			case "tranId":
+				income.TranId = valStr
+			case "tradeId":
+				income.TradeId = valStr
+			case "time":
+				income.Time = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		incomes = append(incomes, income)
+	})
+	return incomes, err
+}
+
+// UnmarshalGetFillsResponse 解析成交明细响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.Fill: 成交明细列表
+//   - error: 错误信息
+func UnmarshalGetFillsResponse(data []byte) ([]model.Fill, error) {
+	var fills []model.Fill
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			fill               model.Fill
+			side, positionSide string
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				fill.Id = valStr
+			case "orderId":
+				fill.OrderId = valStr
+			case "symbol":
+				fill.Symbol = valStr
+			case "side":
+				side = valStr
+			case "positionSide":
+				positionSide = valStr
+			case "price":
+				fill.Price = cast.ToFloat64(valStr)
+			case "qty":
+				fill.Qty = cast.ToFloat64(valStr)
+			case "quoteQty":
+				fill.QuoteQty = cast.ToFloat64(valStr)
+			case "commission":
+				fill.Commission = cast.ToFloat64(valStr)
+			case "commissionAsset":
+				fill.CommissionAsset = valStr
+			case "maker":
+				fill.IsMaker = cast.ToBool(valStr)
+			case "realizedPnl":
+				fill.RealizedPnl = cast.ToFloat64(valStr)
+			case "time":
+				fill.Time = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		//单向持仓模式下成交无法区分开平仓，只返回买卖方向
+		if positionSide == "LONG" || positionSide == "SHORT" {
+			fill.Side = common.AdaptStringToFuturesOrderSide(side, positionSide)
+		} else if side == "BUY" {
+			fill.Side = model.Spot_Buy
+		} else {
+			fill.Side = model.Spot_Sell
+		}
+		if model.ExactDecimalEnabled() {
+			fill.Exact = &model.FillExact{
+				Price:       common.GetDecimal(value, "price"),
+				Qty:         common.GetDecimal(value, "qty"),
+				QuoteQty:    common.GetDecimal(value, "quoteQty"),
+				Commission:  common.GetDecimal(value, "commission"),
+				RealizedPnl: common.GetDecimal(value, "realizedPnl"),
+			}
+		}
+		fills = append(fills, fill)
+	})
+	return fills, err
+}
@@ -0,0 +1,47 @@
+package fapi
+
+import "testing"
+
+func TestUnmarshalTickersResponse(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantSymbols []string
+		wantErr     bool
+	}{
+		{
+			name:        "all items valid",
+			data:        `[{"symbol":"BTCUSDT","lastPrice":"30000.1"},{"symbol":"ETHUSDT","lastPrice":"2000.2"}]`,
+			wantSymbols: []string{"BTCUSDT", "ETHUSDT"},
+		},
+		{
+			name:    "bad item is reported",
+			data:    `[{"symbol":"BTCUSDT","lastPrice":"30000.1"},"bad",{"symbol":"ETHUSDT","lastPrice":"2000.2"}]`,
+			wantErr: true,
+		},
+		{
+			name:    "not an array",
+			data:    `{"code":-1121,"msg":"Invalid symbol."}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tickers, err := UnmarshalTickersResponse([]byte(tt.data))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: UnmarshalTickersResponse() error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if len(tickers) != len(tt.wantSymbols) {
+			t.Errorf("%s: got %d tickers, want %d", tt.name, len(tickers), len(tt.wantSymbols))
+		}
+		for _, sym := range tt.wantSymbols {
+			if tk, ok := tickers[sym]; !ok || tk.Pair.Symbol != sym {
+				t.Errorf("%s: missing ticker %s", tt.name, sym)
+			}
+		}
+	}
+}
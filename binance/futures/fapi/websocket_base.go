@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/logger"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
@@ -18,6 +19,8 @@ type WebSocketBase struct {
 	name                string
 	ws                  websocket.IWebSocketClient
 	baseURL             string
+	listenKeyURL        string
+	symbolRegistry      *common.SymbolRegistry
 	connected           bool
 	mutex               sync.RWMutex
 	depthHandlers       map[string]func(*model.Depth)
@@ -31,7 +34,6 @@ type WebSocketBase struct {
 	errorHandler        func(error)
 	connectedHandler    func()
 	disconnectedHandler func(error)
-	currencyPairM       map[string]model.CurrencyPair
 	apiKey              string
 	apiSecret           string
 	listenKey           string
@@ -43,6 +45,8 @@ func NewWebSocketBase(apiKey, apiSecret string) *WebSocketBase {
 	ws := &WebSocketBase{
 		name:                "binance.com",
 		baseURL:             "wss://fstream.binance.com/ws",
+		listenKeyURL:        "https://fapi.binance.com/fapi/v1/listenKey",
+		symbolRegistry:      NewFApi().SymbolRegistry(),
 		depthHandlers:       make(map[string]func(*model.Depth)),
 		tickerHandlers:      make(map[string]func(*model.Ticker)),
 		klineHandlers:       make(map[string]func([]model.Kline)),
@@ -51,7 +55,6 @@ func NewWebSocketBase(apiKey, apiSecret string) *WebSocketBase {
 		positionHandlers:    make(map[string]func([]model.FuturesPosition)),
 		accountHandlers:     make(map[string]func(map[string]model.FuturesAccount)),
 		orderHandlers:       make(map[string]func(*model.Order)),
-		currencyPairM:       make(map[string]model.CurrencyPair),
 		apiKey:              apiKey,
 		apiSecret:           apiSecret,
 	}
@@ -99,6 +102,42 @@ func NewWebSocketBase(apiKey, apiSecret string) *WebSocketBase {
 	return ws
 }
 
+// WithEndpoints 设置WebSocket地址、listenKey接口地址和交易对信息注册表
+// 参数:
+//   - baseURL: WebSocket地址，如wss://dstream.binance.com/ws
+//   - listenKeyURL: 获取和续期listenKey的完整接口地址
+//   - registry: 交易对信息注册表，如dapi.NewDApi().SymbolRegistry()
+//
+// 注意:
+//   - 币本位合约(dapi)与U本位合约的推送格式一致，通过此方法复用WebSocketBase
+func (ws *WebSocketBase) WithEndpoints(baseURL, listenKeyURL string, registry *common.SymbolRegistry) *WebSocketBase {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.baseURL = baseURL
+	ws.listenKeyURL = listenKeyURL
+	ws.symbolRegistry = registry
+	return ws
+}
+
+// WithSymbolRegistry 使用REST接口的交易对信息注册表，如fApi.SymbolRegistry()，避免重复下载交易所信息
+// 注意:
+//   - 未设置时使用独立的注册表，在Connect时加载
+func (ws *WebSocketBase) WithSymbolRegistry(registry *common.SymbolRegistry) *WebSocketBase {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.symbolRegistry = registry
+	return ws
+}
+
+// registry 返回交易对信息注册表，WithSymbolRegistry可能在推送处理期间被调用，需要加锁读取
+func (ws *WebSocketBase) registry() *common.SymbolRegistry {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+	return ws.symbolRegistry
+}
+
 // GetName 获取交易所名称
 func (ws *WebSocketBase) GetName() string {
 	return ws.name
@@ -113,13 +152,10 @@ func (ws *WebSocketBase) Connect() error {
 		return errors.New("already connected")
 	}
 
-	// 获取交易对信息
-	fapi := NewFApi()
-	currencyPairM, _, err := fapi.GetExchangeInfo()
-	if err != nil {
+	// 获取交易对信息，推送消息中只有symbol，由注册表按symbol索引，已加载时不会重复下载
+	if _, err := ws.symbolRegistry.Load(); err != nil {
 		return fmt.Errorf("failed to get exchange info: %w", err)
 	}
-	ws.currencyPairM = currencyPairM
 
 	// 连接到WebSocket服务器
 	return ws.ws.Connect(ws.baseURL)
@@ -160,7 +196,7 @@ func (ws *WebSocketBase) getListenKey() error {
 	prv := fapi.NewPrvApi(options.WithApiKey(ws.apiKey), options.WithApiSecretKey(ws.apiSecret))
 
 	// 获取listenKey
-	url := "https://fapi.binance.com/fapi/v1/listenKey"
+	url := ws.listenKeyURL
 	headers := map[string]string{
 		"X-MBX-APIKEY": ws.apiKey,
 	}
@@ -210,7 +246,7 @@ func (ws *WebSocketBase) keepAliveListenKey() {
 			// 续期listenKey
 			fapi := NewFApi()
 			prv := fapi.NewPrvApi(options.WithApiKey(ws.apiKey), options.WithApiSecretKey(ws.apiSecret))
-			url := "https://fapi.binance.com/fapi/v1/listenKey"
+			url := ws.listenKeyURL
 			headers := map[string]string{
 				"X-MBX-APIKEY": ws.apiKey,
 			}
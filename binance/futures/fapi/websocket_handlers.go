@@ -6,6 +6,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/logger"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/util"
@@ -117,9 +118,7 @@ func (ws *WebSocketBase) handleDepthMessage(symbol string, data interface{}) err
 	}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		err := fmt.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		logger.Error(err)
@@ -177,9 +176,7 @@ func (ws *WebSocketBase) handleDepthUpdateMessage(msg map[string]interface{}) er
 	}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		err := fmt.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		logger.Error(err)
@@ -235,9 +232,7 @@ func (ws *WebSocketBase) handleOrderUpdateMessage(msg map[string]interface{}) {
 
 	// 设置交易对
 	symbol, _ := data["s"].(string)
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		return
@@ -257,18 +252,15 @@ func (ws *WebSocketBase) handleOrderUpdateMessage(msg map[string]interface{}) {
 
 	// 设置订单类型
 	orderType, _ := data["o"].(string)
-	switch orderType {
-	case "LIMIT":
-		order.OrderTy = model.LIMIT
-	case "MARKET":
-		order.OrderTy = model.MARKET
-	case "STOP":
-		order.OrderTy = model.STOP
-	case "STOP_MARKET":
-		order.OrderTy = model.STOP_MARKET
-	default:
-		order.OrderTy = model.LIMIT
-	}
+	order.OrderTy = common.AdaptStringToOrderType(orderType)
+
+	// 设置条件单数据
+	order.StopPrice, _ = util.ToFloat64(data["sp"])
+	order.ActivationPrice, _ = util.ToFloat64(data["AP"])
+	order.CallbackRate, _ = util.ToFloat64(data["cr"])
+	order.WorkingType, _ = data["wt"].(string)
+	order.PriceProtect, _ = data["pP"].(bool)
+	order.ClosePosition, _ = data["cp"].(bool)
 
 	// 设置订单方向
 	side, _ := data["S"].(string)
@@ -337,9 +329,7 @@ func (ws *WebSocketBase) handleAccountUpdateMessage(msg map[string]interface{})
 
 			// 设置交易对
 			symbol, _ := pos["s"].(string)
-			ws.mutex.RLock()
-			pair, ok := ws.currencyPairM[symbol]
-			ws.mutex.RUnlock()
+			pair, ok := ws.registry().BySymbol(symbol)
 			if !ok {
 				logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 				continue
@@ -448,9 +438,7 @@ func (ws *WebSocketBase) handleTradeMessage(symbol string, data interface{}) err
 	trade := model.Trade{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[tradeData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(tradeData.Symbol)
 	if !ok {
 		err := fmt.Errorf("[Binance Futures] Unknown symbol: %s", tradeData.Symbol)
 		logger.Error(err)
@@ -495,9 +483,7 @@ func (ws *WebSocketBase) handleTradeUpdateMessage(msg map[string]interface{}) {
 	trade := model.Trade{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		return
@@ -555,9 +541,7 @@ func (ws *WebSocketBase) handleFundingRateMessage(symbol string, data interface{
 	fundingRate := &model.FundingRate{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[fundingRateData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(fundingRateData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", fundingRateData.Symbol)
 		return
@@ -591,9 +575,7 @@ func (ws *WebSocketBase) handleFundingRateUpdateMessage(msg map[string]interface
 	fr := &model.FundingRate{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		return
@@ -656,9 +638,7 @@ func (ws *WebSocketBase) handleTickerMessage(symbol string, data interface{}) {
 	ticker := &model.Ticker{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[tickerData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(tickerData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", tickerData.Symbol)
 		return
@@ -694,9 +674,7 @@ func (ws *WebSocketBase) handleTickerUpdateMessage(msg map[string]interface{}) {
 	ticker := &model.Ticker{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		return
@@ -776,9 +754,7 @@ func (ws *WebSocketBase) handleKlineMessage(symbol string, streamType string, da
 	kline := model.Kline{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[klineData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(klineData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", klineData.Symbol)
 		return
@@ -823,9 +799,7 @@ func (ws *WebSocketBase) handleKlineUpdateMessage(msg map[string]interface{}) {
 	kline := model.Kline{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance Futures] Unknown symbol: %s", symbol)
 		return
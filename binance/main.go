@@ -1,30 +1,59 @@
 package binance
 
 import (
+	"github.com/nntaoli-project/goex/v2/binance/futures/dapi"
 	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/binance/options"
+	"github.com/nntaoli-project/goex/v2/binance/papi"
 	"github.com/nntaoli-project/goex/v2/binance/spot"
+	"github.com/nntaoli-project/goex/v2/binance/subaccount"
+	"github.com/nntaoli-project/goex/v2/binance/wallet"
+	goexoptions "github.com/nntaoli-project/goex/v2/options"
 )
 
 type Binance struct {
-	Spot      *spot.Spot
-	Swap      *fapi.FApi
-	SpotWs    *spot.WebSocket
-	FuturesWs *fapi.WebSocket
+	Spot          *spot.Spot
+	Swap          *fapi.FApi
+	CoinFutures   *dapi.DApi    //币本位永续和交割合约
+	Options       *options.EApi //欧式期权
+	Portfolio     *papi.PApi    //统一账户(Portfolio Margin)
+	SpotWs        *spot.WebSocket
+	FuturesWs     *fapi.WebSocket
+	CoinFuturesWs *dapi.WebSocket
+	Wallet        *wallet.Wallet         //充值、提现、万向划转
+	SubAccount    *subaccount.SubAccount //母子账户管理
 }
 
 func New() *Binance {
 	return &Binance{
-		Spot: spot.New(),
-		Swap: fapi.NewFApi(),
+		Spot:        spot.New(),
+		Swap:        fapi.NewFApi(),
+		CoinFutures: dapi.NewDApi(),
+		Options:     options.NewEApi(),
+		Portfolio:   papi.NewPApi(),
+		Wallet:      wallet.New(),
+		SubAccount:  subaccount.New(),
 	}
 }
 
 // NewWithApiKey 使用API密钥创建Binance实例，包括WebSocket支持
 func NewWithApiKey(apiKey, secretKey string) *Binance {
+	// WebSocket与REST共用交易对信息注册表，只下载一次交易所信息
+	spotApi, swap, coinFutures := spot.New(), fapi.NewFApi(), dapi.NewDApi()
+	futuresWs, coinFuturesWs := fapi.NewWebSocket(apiKey, secretKey), dapi.NewWebSocket(apiKey, secretKey)
+	futuresWs.WithSymbolRegistry(swap.SymbolRegistry())
+	coinFuturesWs.WithSymbolRegistry(coinFutures.SymbolRegistry())
+
 	return &Binance{
-		Spot:      spot.New(),
-		Swap:      fapi.NewFApi(),
-		SpotWs:    spot.NewWebSocket(),
-		FuturesWs: fapi.NewWebSocket(apiKey, secretKey),
+		Spot:          spotApi,
+		Swap:          swap,
+		CoinFutures:   coinFutures,
+		Options:       options.NewEApi(),
+		Portfolio:     papi.NewPApi(),
+		Wallet:        wallet.New(goexoptions.WithApiKey(apiKey), goexoptions.WithApiSecretKey(secretKey)),
+		SubAccount:    subaccount.New(goexoptions.WithApiKey(apiKey), goexoptions.WithApiSecretKey(secretKey)),
+		SpotWs:        spot.NewWebSocket().WithApiKey(apiKey, secretKey).WithSymbolRegistry(spotApi.SymbolRegistry()),
+		FuturesWs:     futuresWs,
+		CoinFuturesWs: coinFuturesWs,
 	}
 }
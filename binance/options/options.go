@@ -0,0 +1,108 @@
+package options
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	goexoptions "github.com/nntaoli-project/goex/v2/options"
+)
+
+// EApi 币安欧式期权API实现
+// 包含币安期权(eapi.binance.com)的API接口实现
+type EApi struct {
+	symbolRegistry *common.SymbolRegistry //期权交易对信息，键为期权symbol，如BTC-240628-60000-C
+
+	UriOpts       goexoptions.UriOptions
+	UnmarshalOpts goexoptions.UnmarshalerOptions
+}
+
+// NewEApi 创建币安期权API实例
+// 返回值:
+//   - *EApi: 币安期权API实例
+//
+// 注意:
+//   - 此方法会初始化API的URI和反序列化选项
+//   - 默认使用币安期权的API端点
+func NewEApi() *EApi {
+	e := &EApi{
+		UriOpts: goexoptions.UriOptions{
+			Endpoint:            "https://eapi.binance.com",
+			KlineUri:            "/eapi/v1/klines",
+			TickerUri:           "/eapi/v1/ticker",
+			DepthUri:            "/eapi/v1/depth",
+			GetMarkPriceUri:     "/eapi/v1/mark",
+			GetExchangeInfoUri:  "/eapi/v1/exchangeInfo",
+			NewOrderUri:         "/eapi/v1/order",
+			GetOrderUri:         "/eapi/v1/order",
+			CancelOrderUri:      "/eapi/v1/order",
+			GetPendingOrdersUri: "/eapi/v1/openOrders",
+			GetHistoryOrdersUri: "/eapi/v1/historyOrders",
+			GetAccountUri:       "/eapi/v1/marginAccount",
+			GetPositionsUri:     "/eapi/v1/position",
+		},
+		UnmarshalOpts: goexoptions.UnmarshalerOptions{
+			DepthUnmarshaler:                       UnmarshalDepthResponse,
+			TickersUnmarshaler:                     UnmarshalTickersResponse,
+			KlineUnmarshaler:                       UnmarshalKlinesResponse,
+			GetOptionContractsResponseUnmarshaler:  UnmarshalGetOptionContractsResponse,
+			GetOptionMarkPricesResponseUnmarshaler: UnmarshalGetMarkPricesResponse,
+			CreateOrderResponseUnmarshaler:         UnmarshalGetOrderInfoResponse,
+			GetOrderInfoResponseUnmarshaler:        UnmarshalGetOrderInfoResponse,
+			GetPendingOrdersResponseUnmarshaler:    UnmarshalGetOrdersResponse,
+			GetHistoryOrdersResponseUnmarshaler:    UnmarshalGetOrdersResponse,
+			CancelOrderResponseUnmarshaler:         UnmarshalCancelOrderResponse,
+			GetAccountResponseUnmarshaler:          UnmarshalGetAccountResponse,
+			GetOptionGreeksResponseUnmarshaler:     UnmarshalGetAccountGreeksResponse,
+			GetOptionPositionsResponseUnmarshaler:  UnmarshalGetPositionsResponse,
+		},
+	}
+	e.symbolRegistry = common.NewSymbolRegistry(e.fetchExchangeInfo, common.DefaultSymbolRegistryTTL)
+	return e
+}
+
+// SymbolRegistry 返回期权交易对信息注册表，可以设置有效期或订阅交易对变化
+func (e *EApi) SymbolRegistry() *common.SymbolRegistry {
+	return e.symbolRegistry
+}
+
+// WithUriOption 设置URI选项
+// 参数:
+//   - opts: URI选项函数
+//
+// 返回值:
+//   - *EApi: 当前API实例，用于链式调用
+func (e *EApi) WithUriOption(opts ...goexoptions.UriOption) *EApi {
+	for _, opt := range opts {
+		opt(&e.UriOpts)
+	}
+	return e
+}
+
+// WithUnmarshalOption 设置反序列化选项
+// 参数:
+//   - opts: 反序列化选项函数
+//
+// 返回值:
+//   - *EApi: 当前API实例，用于链式调用
+func (e *EApi) WithUnmarshalOption(opts ...goexoptions.UnmarshalerOption) *EApi {
+	for _, opt := range opts {
+		opt(&e.UnmarshalOpts)
+	}
+	return e
+}
+
+// NewPrvApi 创建币安期权私有API实例
+// 参数:
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安期权私有API实例
+//
+// 使用示例:
+//
+//	prvApi := eApi.NewPrvApi(
+//	  goexoptions.WithApiKey("your-api-key"),
+//	  goexoptions.WithApiSecretKey("your-secret-key"))
+func (e *EApi) NewPrvApi(opts ...goexoptions.ApiOption) *Prv {
+	api := NewPrvApi(e, opts...)
+	api.AuthClient.UriOpts = e.UriOpts
+	return api
+}
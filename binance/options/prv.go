@@ -0,0 +1,329 @@
+package options
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	goexoptions "github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// Prv 币安期权私有API实现
+// 包含需要身份验证的币安期权API接口实现
+type Prv struct {
+	*EApi
+	*common.AuthClient
+}
+
+// NewPrvApi 创建币安期权私有API实例
+// 参数:
+//   - eapi: 币安期权API实例
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安期权私有API实例
+func NewPrvApi(eapi *EApi, opts ...goexoptions.ApiOption) *Prv {
+	var prv = &Prv{
+		AuthClient: &common.AuthClient{},
+	}
+	prv.EApi = eapi
+	for _, opt := range opts {
+		opt(&prv.AuthClient.ApiOpts)
+	}
+	return prv
+}
+
+// GetAccount 获取期权账户资产信息
+// 参数:
+//   - currency: 币种，可为空字符串获取所有币种资产
+//
+// 返回值:
+//   - map[string]model.Account: 账户资产信息，Balance为权益，AvailableBalance为可用，FrozenBalance为冻结
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetAccount(currency string) (map[string]model.Account, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, &url.Values{}, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	accounts, err := p.UnmarshalOpts.GetAccountResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if currency != "" {
+		for coin := range accounts {
+			if coin != currency {
+				delete(accounts, coin)
+			}
+		}
+	}
+
+	return accounts, data, nil
+}
+
+// GetAccountGreeks 获取期权账户按标的汇总的希腊值
+// 参数:
+//   - underlying: 标的，如BTCUSDT，为空时返回所有标的
+//
+// 返回值:
+//   - []model.OptionGreeks: 希腊值列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetAccountGreeks(underlying string) ([]model.OptionGreeks, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, &url.Values{}, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	greeks, err := p.UnmarshalOpts.GetOptionGreeksResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if underlying == "" {
+		return greeks, data, nil
+	}
+
+	var filtered []model.OptionGreeks
+	for _, g := range greeks {
+		if g.Underlying == underlying {
+			filtered = append(filtered, g)
+		}
+	}
+
+	return filtered, data, nil
+}
+
+// CreateOrder 创建期权订单
+// 参数:
+//   - pair: 期权交易对
+//   - qty: 数量(张)
+//   - price: 价格
+//   - side: 订单方向，Spot_Buy/Spot_Sell，也支持期货方向，平仓方向(Futures_CloseBuy/Futures_CloseSell)会设置reduceOnly
+//   - orderTy: 订单类型，期权只支持OrderType_Limit
+//   - opt: 可选参数，如ClientOrderID、postOnly等
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	order, _, err := prvApi.CreateOrder(pair, 0.1, 1500, model.Spot_Buy, model.OrderType_Limit)
+func (p *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (*model.Order, []byte, error) {
+	var param = url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("side", common.AdaptOrderSideToString(side))
+	param.Set("type", common.AdaptOrderTypeToString(orderTy))
+	param.Set("quantity", util.FloatToString(qty, pair.QtyPrecision))
+	param.Set("newOrderRespType", "RESULT")
+
+	if orderTy == model.OrderType_Limit {
+		param.Set("price", util.FloatToString(price, pair.PricePrecision))
+		param.Set("timeInForce", "GTC")
+	}
+
+	switch side {
+	case model.Futures_CloseBuy, model.Futures_CloseSell:
+		param.Set("reduceOnly", "true")
+	}
+
+	util.MergeOptionParams(&param, opt...)
+	if cid := param.Get(model.Order_Client_ID__Opt_Key); cid != "" { //期权接口的自定义ID参数为clientOrderId
+		param.Set("clientOrderId", cid)
+		param.Del(model.Order_Client_ID__Opt_Key)
+	}
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.NewOrderUri, &param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := p.UnmarshalOpts.CreateOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+	ord.Side = side
+
+	return ord, data, nil
+}
+
+// GetOrderInfo 获取订单信息
+// 参数:
+//   - pair: 期权交易对
+//   - id: 订单ID，为空时需要在opt中传入clientOrderId
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetOrderInfo(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (*model.Order, []byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	if id != "" {
+		param.Set("orderId", id)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetOrderUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := p.UnmarshalOpts.GetOrderInfoResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+	ord.Pair = pair
+
+	return ord, data, nil
+}
+
+// GetPendingOrders 获取当前未完成订单列表
+// 参数:
+//   - pair: 期权交易对，Symbol为空时返回所有期权的未完成订单
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []model.Order: 未完成订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPendingOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error) {
+	param := &url.Values{}
+	if pair.Symbol != "" {
+		param.Set("symbol", pair.Symbol)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPendingOrdersUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	orders, err := p.UnmarshalOpts.GetPendingOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	p.fillOrdersPair(orders, pair)
+
+	return orders, data, nil
+}
+
+// GetHistoryOrders 获取历史订单列表
+// 参数:
+//   - pair: 期权交易对
+//   - opt: 可选参数，如startTime、endTime、limit
+//
+// 返回值:
+//   - []model.Order: 历史订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认返回最多500条记录
+func (p *Prv) GetHistoryOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("limit", "500")
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetHistoryOrdersUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	orders, err := p.UnmarshalOpts.GetHistoryOrdersResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	p.fillOrdersPair(orders, pair)
+
+	return orders, data, nil
+}
+
+// CancelOrder 撤销订单
+// 参数:
+//   - pair: 期权交易对
+//   - id: 订单ID，为空时需要在opt中传入clientOrderId
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息，如果为nil则表示撤单成功
+func (p *Prv) CancelOrder(pair model.CurrencyPair, id string, opt ...model.OptionParameter) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("symbol", pair.Symbol)
+	if id != "" {
+		param.Set("orderId", id)
+	}
+
+	util.MergeOptionParams(param, opt...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodDelete, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.CancelOrderUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, p.UnmarshalOpts.CancelOrderResponseUnmarshaler(data)
+}
+
+// GetPositions 获取期权持仓
+// 参数:
+//   - pair: 期权交易对，Symbol为空时返回所有持仓
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.OptionPosition: 持仓列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParameter) ([]model.OptionPosition, []byte, error) {
+	param := &url.Values{}
+	if pair.Symbol != "" {
+		param.Set("symbol", pair.Symbol)
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetPositionsUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	positions, err := p.UnmarshalOpts.GetOptionPositionsResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	pairs := p.symbolRegistry.Pairs()
+	for i := range positions {
+		if cp, ok := pairs[positions[i].Pair.Symbol]; ok {
+			positions[i].Pair = cp
+		}
+	}
+
+	return positions, data, nil
+}
+
+// fillOrdersPair 填充订单的交易对信息，未指定交易对时从缓存的期权合约中查找
+func (p *Prv) fillOrdersPair(orders []model.Order, pair model.CurrencyPair) {
+	pairs := p.symbolRegistry.Pairs()
+	for i := range orders {
+		if pair.Symbol != "" {
+			orders[i].Pair = pair
+		} else if cp, ok := pairs[orders[i].Pair.Symbol]; ok {
+			orders[i].Pair = cp
+		}
+	}
+}
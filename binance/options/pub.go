@@ -0,0 +1,328 @@
+package options
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	. "github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DoNoAuthRequest 执行不需要认证的HTTP请求
+// 参数:
+//   - httpMethod: HTTP方法，如GET、POST等
+//   - reqUrl: 请求URL
+//   - params: 请求参数
+//
+// 返回值:
+//   - []byte: 响应数据
+//   - []byte: 响应数据的副本
+//   - error: 错误信息
+func (e *EApi) DoNoAuthRequest(httpMethod, reqUrl string, params *url.Values) ([]byte, []byte, error) {
+	if http.MethodGet == httpMethod {
+		reqUrl += "?" + params.Encode()
+	}
+
+	responseBody, err := Cli.DoRequest(httpMethod, reqUrl, "", nil)
+	return responseBody, responseBody, err
+}
+
+// GetName 获取交易所名称
+// 返回值:
+//   - string: 返回交易所名称，固定为"binance.com"
+func (e *EApi) GetName() string {
+	return "binance.com"
+}
+
+// GetOptionContracts 获取所有期权合约信息
+// 返回值:
+//   - map[string]model.OptionContract: 期权合约信息，键为期权symbol，如BTC-240628-60000-C
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (e *EApi) GetOptionContracts() (map[string]model.OptionContract, []byte, error) {
+	data, body, err := e.DoNoAuthRequest(http.MethodGet, e.UriOpts.Endpoint+e.UriOpts.GetExchangeInfoUri, &url.Values{})
+	if err != nil {
+		logger.Errorf("[GetOptionContracts] http request error, body: %s", string(body))
+		return nil, body, err
+	}
+
+	contracts, err := e.UnmarshalOpts.GetOptionContractsResponseUnmarshaler(data)
+	if err != nil {
+		logger.Errorf("[GetOptionContracts] unmarshaler data error, err: %s", err.Error())
+		return nil, body, err
+	}
+
+	return contracts, body, nil
+}
+
+// GetExchangeInfo 获取交易所支持的所有期权交易对信息
+// 返回值:
+//   - map[string]model.CurrencyPair: 交易对信息映射，键为期权symbol
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 每次调用都会重新下载并更新SymbolRegistry，NewCurrencyPair会在首次使用时自动加载，无需先调用此方法
+//   - 行权价、到期日、看涨看跌等期权要素通过GetOptionContracts或GetOptionChain获取
+func (e *EApi) GetExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	return e.symbolRegistry.Refresh()
+}
+
+// fetchExchangeInfo 下载期权合约并转换为交易对信息，作为SymbolRegistry的加载方法
+func (e *EApi) fetchExchangeInfo() (map[string]model.CurrencyPair, []byte, error) {
+	contracts, body, err := e.GetOptionContracts()
+	if err != nil {
+		return nil, body, err
+	}
+
+	pairs := make(map[string]model.CurrencyPair, len(contracts))
+	for sym, c := range contracts {
+		pairs[sym] = c.Pair
+	}
+
+	return pairs, body, nil
+}
+
+// GetOptionChain 获取期权链
+// 参数:
+//   - underlying: 标的，如BTCUSDT，为空时返回所有标的
+//
+// 返回值:
+//   - []model.OptionContract: 期权合约列表，按到期时间、行权价升序，同一行权价看涨在前
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	chain, _, err := eApi.GetOptionChain("BTCUSDT")
+func (e *EApi) GetOptionChain(underlying string) ([]model.OptionContract, []byte, error) {
+	contracts, body, err := e.GetOptionContracts()
+	if err != nil {
+		return nil, body, err
+	}
+
+	chain := make([]model.OptionContract, 0, len(contracts))
+	for _, c := range contracts {
+		if underlying == "" || c.Underlying == underlying {
+			chain = append(chain, c)
+		}
+	}
+
+	sort.Slice(chain, func(i, j int) bool {
+		if chain[i].ExpiryDate != chain[j].ExpiryDate {
+			return chain[i].ExpiryDate < chain[j].ExpiryDate
+		}
+		if chain[i].StrikePrice != chain[j].StrikePrice {
+			return chain[i].StrikePrice < chain[j].StrikePrice
+		}
+		return chain[i].OptionSide == model.OptionSide_Call && chain[j].OptionSide != model.OptionSide_Call
+	})
+
+	return chain, body, nil
+}
+
+// NewCurrencyPair 创建期权交易对
+// 参数:
+//   - baseSym: 基础货币符号，如BTC
+//   - quoteSym: 计价货币符号，如USDT
+//   - opts: 期权要素，model.Option_Expiry__Opt_Key(YYMMDD)、model.Option_Strike__Opt_Key、model.Option_Side__Opt_Key
+//
+// 返回值:
+//   - model.CurrencyPair: 交易对信息，Symbol如BTC-240628-60000-C
+//   - error: 错误信息，如果期权合约不存在则返回错误
+//
+// 注意:
+//   - 首次调用时自动加载期权合约信息，之后超过有效期会在后台刷新，已到期下架的合约随之移除
+//
+// 使用示例:
+//
+//	pair, err := eApi.NewCurrencyPair(model.BTC, model.USDT,
+//	  model.OptionParameter{Key: model.Option_Expiry__Opt_Key, Value: "240628"},
+//	  model.OptionParameter{Key: model.Option_Strike__Opt_Key, Value: "60000"},
+//	  model.OptionParameter{Key: model.Option_Side__Opt_Key, Value: "CALL"})
+func (e *EApi) NewCurrencyPair(baseSym, quoteSym string, opts ...model.OptionParameter) (model.CurrencyPair, error) {
+	var expiry, strike, side string
+	for _, opt := range opts {
+		switch opt.Key {
+		case model.Option_Expiry__Opt_Key:
+			expiry = opt.Value
+		case model.Option_Strike__Opt_Key:
+			strike = opt.Value
+		case model.Option_Side__Opt_Key:
+			side = strings.ToUpper(opt.Value[:min(len(opt.Value), 1)])
+		}
+	}
+
+	if expiry == "" || strike == "" || side == "" {
+		return model.CurrencyPair{}, errors.New("option expiry, strike and side are required")
+	}
+
+	symbol := fmt.Sprintf("%s-%s-%s-%s", baseSym, expiry, strike, side)
+
+	pairs, err := e.symbolRegistry.Load()
+	if err != nil {
+		return model.CurrencyPair{}, fmt.Errorf("load exchange info error: %w", err)
+	}
+
+	currencyPair, ok := pairs[symbol]
+	if !ok || currencyPair.QuoteSymbol != quoteSym {
+		return model.CurrencyPair{}, fmt.Errorf("not found currency pair: %s", symbol)
+	}
+
+	return currencyPair, nil
+}
+
+// GetDepth 获取期权的深度数据
+// 参数:
+//   - pair: 交易对信息
+//   - limit: 返回的深度数量，可选10、20、50、100、500、1000
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.Depth: 深度数据
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (e *EApi) GetDepth(pair model.CurrencyPair, limit int, opt ...model.OptionParameter) (depth *model.Depth, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(limit))
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := e.DoNoAuthRequest(http.MethodGet, e.UriOpts.Endpoint+e.UriOpts.DepthUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	dep, err := e.UnmarshalOpts.DepthUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+	dep.Pair = pair
+
+	return dep, responseBody, nil
+}
+
+// GetTicker 获取期权的24小时行情
+// 参数:
+//   - pair: 交易对信息
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.Ticker: 行情数据
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 期权行情接口即使指定symbol也返回数组
+func (e *EApi) GetTicker(pair model.CurrencyPair, opt ...model.OptionParameter) (ticker *model.Ticker, responseBody []byte, err error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := e.DoNoAuthRequest(http.MethodGet, e.UriOpts.Endpoint+e.UriOpts.TickerUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	tickers, err := e.UnmarshalOpts.TickersUnmarshaler(data)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	ticker, ok := tickers[pair.Symbol]
+	if !ok {
+		return nil, responseBody, fmt.Errorf("ticker of %s not found", pair.Symbol)
+	}
+	ticker.Pair = pair
+
+	return ticker, responseBody, nil
+}
+
+// GetKline 获取期权K线数据
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []model.Kline: K线数据数组
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认返回100条数据，可以通过opt参数修改limit值
+func (e *EApi) GetKline(pair model.CurrencyPair, period model.KlinePeriod, opt ...model.OptionParameter) (klines []model.Kline, responseBody []byte, err error) {
+	var param = url.Values{}
+	param.Set("symbol", pair.Symbol)
+	param.Set("interval", common.AdaptKlinePeriodToSymbol(period))
+	param.Set("limit", "100")
+
+	util.MergeOptionParams(&param, opt...)
+
+	data, responseBody, err := e.DoNoAuthRequest(http.MethodGet, e.UriOpts.Endpoint+e.UriOpts.KlineUri, &param)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	klines, err = e.UnmarshalOpts.KlineUnmarshaler(data)
+
+	for i := range klines {
+		klines[i].Pair = pair
+	}
+
+	return klines, responseBody, err
+}
+
+// GetMarkPrice 获取期权的标记价格、隐含波动率和希腊值
+// 参数:
+//   - pair: 交易对信息
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.OptionMarkPrice: 标记价格和希腊值
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (e *EApi) GetMarkPrice(pair model.CurrencyPair, opt ...model.OptionParameter) (*model.OptionMarkPrice, []byte, error) {
+	marks, body, err := e.GetMarkPrices(append([]model.OptionParameter{{Key: "symbol", Value: pair.Symbol}}, opt...)...)
+	if err != nil {
+		return nil, body, err
+	}
+
+	for i := range marks {
+		if marks[i].Symbol == pair.Symbol {
+			return &marks[i], body, nil
+		}
+	}
+
+	return nil, body, fmt.Errorf("mark price of %s not found", pair.Symbol)
+}
+
+// GetMarkPrices 批量获取期权的标记价格、隐含波动率和希腊值
+// 参数:
+//   - opt: 可选参数，不传symbol时返回所有期权
+//
+// 返回值:
+//   - []model.OptionMarkPrice: 标记价格和希腊值列表
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (e *EApi) GetMarkPrices(opt ...model.OptionParameter) ([]model.OptionMarkPrice, []byte, error) {
+	params := url.Values{}
+
+	util.MergeOptionParams(&params, opt...)
+
+	data, responseBody, err := e.DoNoAuthRequest(http.MethodGet, e.UriOpts.Endpoint+e.UriOpts.GetMarkPriceUri, &params)
+	if err != nil {
+		return nil, responseBody, err
+	}
+
+	marks, err := e.UnmarshalOpts.GetOptionMarkPricesResponseUnmarshaler(data)
+	return marks, responseBody, err
+}
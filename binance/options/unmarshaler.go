@@ -0,0 +1,451 @@
+package options
+
+import (
+	"errors"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"math"
+	"strings"
+	"time"
+)
+
+// UnmarshalDepthResponse 解析期权深度数据响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.Depth: 深度数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 期权深度的时间字段为T，买卖盘格式与期货一致
+func UnmarshalDepthResponse(data []byte) (*model.Depth, error) {
+	dep, err := fapi.UnmarshalDepthResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if tm, err := jsonparser.GetInt(data, "T"); err == nil {
+		dep.UTime = time.UnixMilli(tm)
+	}
+
+	return dep, nil
+}
+
+// UnmarshalTickersResponse 解析期权24小时行情响应
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - map[string]*model.Ticker: 行情数据映射，键为期权symbol
+//   - error: 错误信息
+func UnmarshalTickersResponse(data []byte) (map[string]*model.Ticker, error) {
+	var tickers = make(map[string]*model.Ticker, 200)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var tk model.Ticker
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				tk.Pair = model.CurrencyPair{Symbol: valStr}
+			case "lastPrice":
+				tk.Last = cast.ToFloat64(valStr)
+			case "high":
+				tk.High = cast.ToFloat64(valStr)
+			case "low":
+				tk.Low = cast.ToFloat64(valStr)
+			case "volume":
+				tk.Vol = cast.ToFloat64(valStr)
+			case "priceChangePercent":
+				tk.Percent = cast.ToFloat64(valStr) * 100
+			case "bidPrice":
+				tk.Buy = cast.ToFloat64(valStr)
+			case "askPrice":
+				tk.Sell = cast.ToFloat64(valStr)
+			case "closeTime":
+				tk.Timestamp = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		tickers[tk.Pair.Symbol] = &tk
+	})
+	return tickers, err
+}
+
+// UnmarshalKlinesResponse 解析期权K线数据响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.Kline: K线数据列表
+//   - error: 错误信息
+//
+// 注意:
+//   - 期权K线为对象数组，与现货、期货的二维数组格式不同
+func UnmarshalKlinesResponse(data []byte) ([]model.Kline, error) {
+	var klines []model.Kline
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var k model.Kline
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "openTime":
+				k.Timestamp = cast.ToInt64(valStr)
+			case "open":
+				k.Open = cast.ToFloat64(valStr)
+			case "high":
+				k.High = cast.ToFloat64(valStr)
+			case "low":
+				k.Low = cast.ToFloat64(valStr)
+			case "close":
+				k.Close = cast.ToFloat64(valStr)
+			case "volume":
+				k.Vol = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		klines = append(klines, k)
+	})
+	return klines, err
+}
+
+// UnmarshalGetOptionContractsResponse 解析期权交易所信息响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - map[string]model.OptionContract: 期权合约信息，键为期权symbol
+//   - error: 错误信息
+//
+// 注意:
+//   - 解析optionSymbols数组，BaseSymbol取自symbol前缀，ContractVal为合约乘数(unit)
+//   - ContractDeliveryDate与ExpiryDate均为到期时间，SettlementCurrency为报价资产
+func UnmarshalGetOptionContractsResponse(data []byte) (map[string]model.OptionContract, error) {
+	var (
+		contracts = make(map[string]model.OptionContract, 1000)
+		err       error
+	)
+
+	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var c model.OptionContract
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				c.Pair.Symbol = valStr
+				if idx := strings.Index(valStr, "-"); idx > 0 {
+					c.Pair.BaseSymbol = valStr[:idx]
+				}
+			case "side":
+				c.OptionSide = model.OptionSide(valStr)
+			case "strikePrice":
+				c.StrikePrice = cast.ToFloat64(valStr)
+			case "underlying":
+				c.Underlying = valStr
+			case "unit":
+				c.Pair.ContractVal = cast.ToFloat64(valStr)
+			case "expiryDate":
+				c.ExpiryDate = cast.ToInt64(valStr)
+				c.Pair.ContractDeliveryDate = c.ExpiryDate
+			case "priceScale":
+				c.Pair.PricePrecision = cast.ToInt(valStr)
+			case "quantityScale":
+				c.Pair.QtyPrecision = cast.ToInt(valStr)
+			case "quoteAsset":
+				c.Pair.QuoteSymbol = valStr
+				c.Pair.SettlementCurrency = valStr
+			case "minQty":
+				c.Pair.MinQty = cast.ToFloat64(valStr)
+			case "maxQty":
+				c.Pair.MaxQty = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+
+		if c.Pair.QtyPrecision == 0 && c.Pair.MinQty > 0 && c.Pair.MinQty < 1 {
+			c.Pair.QtyPrecision = int(math.Round(-math.Log10(c.Pair.MinQty)))
+		}
+
+		contracts[c.Pair.Symbol] = c
+	}, "optionSymbols")
+
+	return contracts, err
+}
+
+// UnmarshalGetMarkPricesResponse 解析期权标记价格响应
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - []model.OptionMarkPrice: 标记价格、隐含波动率和希腊值列表
+//   - error: 错误信息
+func UnmarshalGetMarkPricesResponse(data []byte) ([]model.OptionMarkPrice, error) {
+	var marks []model.OptionMarkPrice
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var mark model.OptionMarkPrice
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				mark.Symbol = valStr
+			case "markPrice":
+				mark.MarkPrice = cast.ToFloat64(valStr)
+			case "bidIV":
+				mark.BidIV = cast.ToFloat64(valStr)
+			case "askIV":
+				mark.AskIV = cast.ToFloat64(valStr)
+			case "markIV":
+				mark.MarkIV = cast.ToFloat64(valStr)
+			case "delta":
+				mark.Greeks.Delta = cast.ToFloat64(valStr)
+			case "gamma":
+				mark.Greeks.Gamma = cast.ToFloat64(valStr)
+			case "theta":
+				mark.Greeks.Theta = cast.ToFloat64(valStr)
+			case "vega":
+				mark.Greeks.Vega = cast.ToFloat64(valStr)
+			case "highPriceLimit":
+				mark.HighPriceLimit = cast.ToFloat64(valStr)
+			case "lowPriceLimit":
+				mark.LowPriceLimit = cast.ToFloat64(valStr)
+			case "riskFreeInterest":
+				mark.RiskFreeInterest = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		marks = append(marks, mark)
+	})
+	return marks, err
+}
+
+// UnmarshalGetOrderInfoResponse 解析期权订单响应，下单和查询订单共用
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - error: 错误信息
+//
+// 注意:
+//   - 订单的Pair只包含Symbol，由调用方补全
+//   - ACCEPTED为挂单中，REJECTED视为已撤销
+func UnmarshalGetOrderInfoResponse(data []byte) (*model.Order, error) {
+	var ord model.Order
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "orderId":
+			ord.Id = valStr
+		case "clientOrderId":
+			ord.CId = valStr
+		case "symbol":
+			ord.Pair.Symbol = valStr
+		case "price":
+			ord.Price = cast.ToFloat64(valStr)
+		case "quantity":
+			ord.Qty = cast.ToFloat64(valStr)
+		case "executedQty":
+			ord.ExecutedQty = cast.ToFloat64(valStr)
+		case "avgPrice":
+			ord.PriceAvg = cast.ToFloat64(valStr)
+		case "fee":
+			ord.Fee = cast.ToFloat64(valStr)
+		case "quoteAsset":
+			ord.FeeCcy = valStr
+		case "side":
+			if valStr == "BUY" {
+				ord.Side = model.Spot_Buy
+			} else {
+				ord.Side = model.Spot_Sell
+			}
+		case "type":
+			ord.OrderTy = common.AdaptStringToOrderType(valStr)
+		case "createTime":
+			ord.CreatedAt = cast.ToInt64(valStr)
+		case "updateTime":
+			ord.FinishedAt = cast.ToInt64(valStr)
+		case "status":
+			ord.Status = adaptStringToOrderStatus(valStr)
+		}
+		return nil
+	})
+
+	if ord.Status == model.OrderStatus_Canceled {
+		ord.CanceledAt = ord.FinishedAt
+	}
+
+	return &ord, err
+}
+
+// UnmarshalGetOrdersResponse 解析期权订单列表响应，未完成订单和历史订单共用
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - []model.Order: 订单列表
+//   - error: 错误信息
+func UnmarshalGetOrdersResponse(data []byte) ([]model.Order, error) {
+	var orders []model.Order
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		ord, err := UnmarshalGetOrderInfoResponse(value)
+		if err != nil {
+			return
+		}
+		orders = append(orders, *ord)
+	})
+	return orders, err
+}
+
+// UnmarshalCancelOrderResponse 解析期权撤单响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - error: 错误信息，如果为nil则表示撤单成功
+func UnmarshalCancelOrderResponse(data []byte) error {
+	_, err := jsonparser.GetInt(data, "code")
+	if err == nil {
+		return errors.New(string(data))
+	}
+	return nil
+}
+
+// UnmarshalGetAccountResponse 解析期权账户资产响应
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - map[string]model.Account: 账户资产映射，键为币种
+//   - error: 错误信息
+//
+// 注意:
+//   - 解析asset数组，Balance为账户权益，AvailableBalance为可用，FrozenBalance为冻结
+func UnmarshalGetAccountResponse(data []byte) (map[string]model.Account, error) {
+	var accounts = make(map[string]model.Account, 2)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var acc model.Account
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "equity":
+				acc.Balance = cast.ToFloat64(valStr)
+			case "available":
+				acc.AvailableBalance = cast.ToFloat64(valStr)
+			case "locked":
+				acc.FrozenBalance = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		accounts[acc.Coin] = acc
+	}, "asset")
+	return accounts, err
+}
+
+// UnmarshalGetAccountGreeksResponse 解析期权账户按标的汇总的希腊值
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - []model.OptionGreeks: 希腊值列表
+//   - error: 错误信息
+//
+// 注意:
+//   - 解析账户信息中的greek数组
+func UnmarshalGetAccountGreeksResponse(data []byte) ([]model.OptionGreeks, error) {
+	var greeks []model.OptionGreeks
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var g model.OptionGreeks
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "underlying":
+				g.Underlying = valStr
+			case "delta":
+				g.Delta = cast.ToFloat64(valStr)
+			case "gamma":
+				g.Gamma = cast.ToFloat64(valStr)
+			case "theta":
+				g.Theta = cast.ToFloat64(valStr)
+			case "vega":
+				g.Vega = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		greeks = append(greeks, g)
+	}, "greek")
+	return greeks, err
+}
+
+// UnmarshalGetPositionsResponse 解析期权持仓响应
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - []model.OptionPosition: 持仓列表
+//   - error: 错误信息
+//
+// 注意:
+//   - LONG为买入期权(Futures_OpenBuy)，SHORT为卖出期权(Futures_OpenSell)
+//   - 持仓的Pair只包含Symbol，由调用方补全
+func UnmarshalGetPositionsResponse(data []byte) ([]model.OptionPosition, error) {
+	var positions []model.OptionPosition
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var pos model.OptionPosition
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				pos.Pair.Symbol = valStr
+			case "side":
+				if valStr == "SHORT" {
+					pos.PosSide = model.Futures_OpenSell
+				} else {
+					pos.PosSide = model.Futures_OpenBuy
+				}
+			case "quantity":
+				pos.Qty = cast.ToFloat64(valStr)
+			case "reducibleQty":
+				pos.ReducibleQty = cast.ToFloat64(valStr)
+			case "entryPrice":
+				pos.AvgPx = cast.ToFloat64(valStr)
+			case "markPrice":
+				pos.MarkPx = cast.ToFloat64(valStr)
+			case "markValue":
+				pos.MarkValue = cast.ToFloat64(valStr)
+			case "unrealizedPNL":
+				pos.Upl = cast.ToFloat64(valStr)
+			case "ror":
+				pos.UplRatio = cast.ToFloat64(valStr)
+			case "strikePrice":
+				pos.StrikePrice = cast.ToFloat64(valStr)
+			case "expiryDate":
+				pos.ExpiryDate = cast.ToInt64(valStr)
+			case "optionSide":
+				pos.OptionSide = model.OptionSide(valStr)
+			}
+			return nil
+		})
+		positions = append(positions, pos)
+	})
+	return positions, err
+}
+
+// adaptStringToOrderStatus 期权订单状态转换
+func adaptStringToOrderStatus(st string) model.OrderStatus {
+	switch st {
+	case "ACCEPTED":
+		return model.OrderStatus_Pending
+	case "PARTIALLY_FILLED":
+		return model.OrderStatus_PartFinished
+	case "FILLED":
+		return model.OrderStatus_Finished
+	case "CANCELLED", "REJECTED":
+		return model.OrderStatus_Canceled
+	}
+	return model.OrderStatus(-1)
+}
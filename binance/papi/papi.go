@@ -0,0 +1,160 @@
+package papi
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/futures/dapi"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/binance/spot"
+	"github.com/nntaoli-project/goex/v2/options"
+)
+
+// PApi 币安统一账户(Portfolio Margin)API实现
+// 统一账户只有私有接口，行情和交易对信息仍通过UM(fapi)、CM(dapi)和Spot获取
+type PApi struct {
+	UM   *fapi.FApi //U本位合约，用于获取交易对和行情
+	CM   *dapi.DApi //币本位合约，用于获取交易对和行情
+	Spot *spot.Spot //现货，用于获取杠杆交易对和行情
+
+	UriOpts       options.UriOptions
+	UnmarshalOpts options.UnmarshalerOptions
+}
+
+// NewPApi 创建币安统一账户API实例
+// 返回值:
+//   - *PApi: 币安统一账户API实例
+//
+// 注意:
+//   - 此方法会初始化统一账户接口的URI和反序列化选项
+//   - 默认使用币安统一账户的API端点(https://papi.binance.com)
+//   - UM、CM、Spot为统一账户专用实例，其账户类接口的反序列化方法替换为统一账户的返回格式
+func NewPApi() *PApi {
+	p := &PApi{
+		UM: fapi.NewFApi().WithUnmarshalOption(
+			options.WithGetAccountResponseUnmarshaler(UnmarshalGetAccountResponse),
+			options.WithGetFuturesAccountResponseUnmarshaler(UnmarshalGetContractAccountResponse)),
+		CM: dapi.NewDApi().WithUnmarshalOption(
+			options.WithGetAccountResponseUnmarshaler(UnmarshalGetAccountResponse),
+			options.WithGetFuturesAccountResponseUnmarshaler(UnmarshalGetContractAccountResponse)),
+		Spot: spot.New().WithUnmarshalerOptions(
+			options.WithGetAccountResponseUnmarshaler(UnmarshalGetAccountResponse)),
+		UriOpts: options.UriOptions{
+			Endpoint:                "https://papi.binance.com",
+			GetAccountUri:           "/papi/v1/balance",
+			GetUnifiedAccountUri:    "/papi/v1/account",
+			AutoRepayFuturesUri:     "/papi/v1/repay-futures-switch",
+			RepayNegativeBalanceUri: "/papi/v1/repay-futures-negative-balance",
+		},
+		UnmarshalOpts: options.UnmarshalerOptions{
+			GetAccountResponseUnmarshaler:          UnmarshalGetAccountResponse,
+			GetFuturesAccountResponseUnmarshaler:   UnmarshalGetFuturesAccountResponse,
+			GetUnifiedAccountResponseUnmarshaler:   UnmarshalGetUnifiedAccountResponse,
+			GetUnifiedBalanceResponseUnmarshaler:   UnmarshalGetUnifiedBalanceResponse,
+			GetAutoRepayFuturesResponseUnmarshaler: UnmarshalGetAutoRepayFuturesResponse,
+		},
+	}
+
+	return p
+}
+
+// WithUriOption 设置URI选项
+// 参数:
+//   - opts: URI选项函数
+//
+// 返回值:
+//   - *PApi: 当前API实例，用于链式调用
+//
+// 注意:
+//   - 设置的Endpoint同样作用于UM、CM和杠杆的下单接口
+func (p *PApi) WithUriOption(opts ...options.UriOption) *PApi {
+	for _, opt := range opts {
+		opt(&p.UriOpts)
+	}
+	return p
+}
+
+// WithUnmarshalOption 设置反序列化选项
+// 参数:
+//   - opts: 反序列化选项函数
+//
+// 返回值:
+//   - *PApi: 当前API实例，用于链式调用
+func (p *PApi) WithUnmarshalOption(opts ...options.UnmarshalerOption) *PApi {
+	for _, opt := range opts {
+		opt(&p.UnmarshalOpts)
+	}
+	return p
+}
+
+// NewPrvApi 创建币安统一账户私有API实例
+// 参数:
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安统一账户私有API实例
+//
+// 使用示例:
+//
+//	prvApi := pApi.NewPrvApi(
+//	  options.WithApiKey("your-api-key"),
+//	  options.WithApiSecretKey("your-secret-key"))
+func (p *PApi) NewPrvApi(opts ...options.ApiOption) *Prv {
+	api := NewPrvApi(p, opts...)
+	api.AuthClient.UriOpts = p.UriOpts
+	return api
+}
+
+// umUriOptions U本位合约在统一账户下的私有接口
+// 注意:
+//   - 统一账户不支持批量下单、逐仓保证金和保证金模式设置，对应URI为空
+func umUriOptions(endpoint string) options.UriOptions {
+	return options.UriOptions{
+		Endpoint:              endpoint,
+		NewOrderUri:           "/papi/v1/um/order",
+		AmendOrderUri:         "/papi/v1/um/order",
+		GetOrderUri:           "/papi/v1/um/order",
+		CancelOrderUri:        "/papi/v1/um/order",
+		GetPendingOrdersUri:   "/papi/v1/um/openOrders",
+		GetHistoryOrdersUri:   "/papi/v1/um/allOrders",
+		GetAccountUri:         "/papi/v1/balance",
+		GetFuturesAccountUri:  "/papi/v1/um/account",
+		GetPositionsUri:       "/papi/v1/um/positionRisk",
+		SetPositionModeUri:    "/papi/v1/um/positionSide/dual",
+		GetPositionModeUri:    "/papi/v1/um/positionSide/dual",
+		SetLeverageUri:        "/papi/v1/um/leverage",
+		GetLeverageUri:        "/papi/v1/um/positionRisk",
+		GetLeverageBracketUri: "/papi/v1/um/leverageBracket",
+		GetFillsUri:           "/papi/v1/um/userTrades",
+		GetIncomeHistoryUri:   "/papi/v1/um/income",
+	}
+}
+
+// cmUriOptions 币本位合约在统一账户下的私有接口
+func cmUriOptions(endpoint string) options.UriOptions {
+	return options.UriOptions{
+		Endpoint:             endpoint,
+		NewOrderUri:          "/papi/v1/cm/order",
+		GetOrderUri:          "/papi/v1/cm/order",
+		CancelOrderUri:       "/papi/v1/cm/order",
+		GetPendingOrdersUri:  "/papi/v1/cm/openOrders",
+		GetHistoryOrdersUri:  "/papi/v1/cm/allOrders",
+		GetAccountUri:        "/papi/v1/balance",
+		GetFuturesAccountUri: "/papi/v1/cm/account",
+		GetPositionsUri:      "/papi/v1/cm/positionRisk",
+		SetPositionModeUri:   "/papi/v1/cm/positionSide/dual",
+		GetPositionModeUri:   "/papi/v1/cm/positionSide/dual",
+		SetLeverageUri:       "/papi/v1/cm/leverage",
+	}
+}
+
+// marginUriOptions 全仓杠杆在统一账户下的私有接口
+func marginUriOptions(endpoint string) options.UriOptions {
+	return options.UriOptions{
+		Endpoint:            endpoint,
+		NewOrderUri:         "/papi/v1/margin/order",
+		GetOrderUri:         "/papi/v1/margin/order",
+		CancelOrderUri:      "/papi/v1/margin/order",
+		GetPendingOrdersUri: "/papi/v1/margin/openOrders",
+		GetHistoryOrdersUri: "/papi/v1/margin/allOrders",
+		GetAccountUri:       "/papi/v1/balance",
+		GetFillsUri:         "/papi/v1/margin/myTrades",
+	}
+}
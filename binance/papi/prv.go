@@ -0,0 +1,326 @@
+package papi
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/binance/futures/dapi"
+	"github.com/nntaoli-project/goex/v2/binance/futures/fapi"
+	"github.com/nntaoli-project/goex/v2/binance/spot"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Prv 币安统一账户私有API实现
+// 实现期货私有接口，按交易对把下单、查单、撤单和持仓请求路由到U本位或币本位合约
+type Prv struct {
+	*PApi
+	*common.AuthClient
+
+	UMPrv     *fapi.Prv    //U本位合约私有接口，指向/papi/v1/um
+	CMPrv     *dapi.Prv    //币本位合约私有接口，指向/papi/v1/cm
+	MarginPrv *spot.PrvApi //全仓杠杆私有接口，指向/papi/v1/margin
+}
+
+// futuresPrv U本位和币本位合约私有接口的公共部分
+type futuresPrv interface {
+	CreateOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (*model.Order, []byte, error)
+	GetOrderInfo(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (*model.Order, []byte, error)
+	GetPendingOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error)
+	GetHistoryOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error)
+	CancelOrder(pair model.CurrencyPair, id string, opt ...model.OptionParameter) ([]byte, error)
+	GetPositions(pair model.CurrencyPair, opts ...model.OptionParameter) ([]model.FuturesPosition, []byte, error)
+}
+
+// NewPrvApi 创建币安统一账户私有API实例
+// 参数:
+//   - papi: 币安统一账户API实例
+//   - opts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Prv: 币安统一账户私有API实例
+//
+// 注意:
+//   - 同时创建UMPrv、CMPrv和MarginPrv，使用相同的API密钥和统一账户的Endpoint
+func NewPrvApi(papi *PApi, opts ...options.ApiOption) *Prv {
+	var prv = &Prv{
+		AuthClient: &common.AuthClient{},
+	}
+	prv.PApi = papi
+	for _, opt := range opts {
+		opt(&prv.AuthClient.ApiOpts)
+	}
+
+	prv.UMPrv = fapi.NewPrvApi(papi.UM, opts...)
+	prv.UMPrv.AuthClient.UriOpts = umUriOptions(papi.UriOpts.Endpoint)
+
+	prv.CMPrv = dapi.NewPrvApi(papi.CM, opts...)
+	prv.CMPrv.AuthClient.UriOpts = cmUriOptions(papi.UriOpts.Endpoint)
+
+	prv.MarginPrv = spot.NewPrvApi(opts...)
+	prv.MarginPrv.Spot = papi.Spot
+	prv.MarginPrv.AuthClient.UriOpts = marginUriOptions(papi.UriOpts.Endpoint)
+
+	return prv
+}
+
+// futures 根据交易对选择合约私有接口，币本位合约以USD计价，其余为U本位合约
+func (p *Prv) futures(pair model.CurrencyPair) futuresPrv {
+	if pair.QuoteSymbol == model.USD {
+		return p.CMPrv
+	}
+	return p.UMPrv
+}
+
+// GetAccount 获取统一账户资产信息
+// 参数:
+//   - currency: 币种，可为空字符串获取所有币种资产
+//
+// 返回值:
+//   - map[string]model.Account: 账户资产信息，Balance为钱包余额，AvailableBalance为全仓杠杆可用
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetAccount(currency string) (map[string]model.Account, []byte, error) {
+	param := &url.Values{}
+	if currency != "" {
+		param.Set("asset", currency)
+	}
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	accounts, err := p.UnmarshalOpts.GetAccountResponseUnmarshaler(wrapArray(data))
+	return accounts, data, err
+}
+
+// CreateOrder 创建合约订单
+// 参数:
+//   - pair: 交易对，通过UM或CM的NewCurrencyPair获取
+//   - qty: 数量，币本位合约单位为张
+//   - price: 价格
+//   - side: 订单方向(Futures_OpenBuy/Futures_OpenSell/Futures_CloseBuy/Futures_CloseSell)
+//   - orderTy: 订单类型
+//   - opt: 可选参数，如ClientOrderID等
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 计价币为USD的交易对通过/papi/v1/cm/order下单，其余通过/papi/v1/um/order下单
+//   - 统一账户的条件单使用单独的接口，不支持通过此方法下条件单
+//   - 全仓杠杆订单使用MarginPrv.CreateOrder
+//
+// 使用示例:
+//
+//	pair, _ := pApi.UM.NewCurrencyPair(model.BTC, model.USDT)
+//	order, _, err := prvApi.CreateOrder(pair, 0.01, 30000, model.Futures_OpenBuy, model.OrderType_Limit)
+func (p *Prv) CreateOrder(pair model.CurrencyPair, qty, price float64, side model.OrderSide, orderTy model.OrderType, opt ...model.OptionParameter) (*model.Order, []byte, error) {
+	return p.futures(pair).CreateOrder(pair, qty, price, side, orderTy, opt...)
+}
+
+// GetOrderInfo 获取合约订单信息
+// 参数:
+//   - pair: 交易对
+//   - id: 订单ID
+//   - opt: 可选参数
+//
+// 返回值:
+//   - *model.Order: 订单信息
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetOrderInfo(pair model.CurrencyPair, id string, opt ...model.OptionParameter) (*model.Order, []byte, error) {
+	return p.futures(pair).GetOrderInfo(pair, id, opt...)
+}
+
+// GetPendingOrders 获取合约当前未完成订单列表
+// 参数:
+//   - pair: 交易对
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []model.Order: 未完成订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPendingOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error) {
+	return p.futures(pair).GetPendingOrders(pair, opt...)
+}
+
+// GetHistoryOrders 获取合约历史订单列表
+// 参数:
+//   - pair: 交易对
+//   - opt: 可选参数，如时间范围等
+//
+// 返回值:
+//   - []model.Order: 历史订单列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetHistoryOrders(pair model.CurrencyPair, opt ...model.OptionParameter) ([]model.Order, []byte, error) {
+	return p.futures(pair).GetHistoryOrders(pair, opt...)
+}
+
+// CancelOrder 撤销合约订单
+// 参数:
+//   - pair: 交易对
+//   - id: 订单ID
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息，如果为nil则表示撤单成功
+func (p *Prv) CancelOrder(pair model.CurrencyPair, id string, opt ...model.OptionParameter) ([]byte, error) {
+	return p.futures(pair).CancelOrder(pair, id, opt...)
+}
+
+// GetFuturesAccount 获取统一账户各资产的权益
+// 参数:
+//   - currency: 币种，可为空字符串获取所有币种资产
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 账户信息，Eq包含U本位和币本位合约的未实现盈亏
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 单独的U本位或币本位合约账户使用UMPrv.GetFuturesAccount或CMPrv.GetFuturesAccount
+func (p *Prv) GetFuturesAccount(currency string) (map[string]model.FuturesAccount, []byte, error) {
+	param := &url.Values{}
+	if currency != "" {
+		param.Set("asset", currency)
+	}
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	acc, err := p.UnmarshalOpts.GetFuturesAccountResponseUnmarshaler(wrapArray(data))
+	return acc, data, err
+}
+
+// GetPositions 获取合约持仓信息
+// 参数:
+//   - pair: 交易对
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []model.FuturesPosition: 持仓信息列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetPositions(pair model.CurrencyPair, opts ...model.OptionParameter) ([]model.FuturesPosition, []byte, error) {
+	return p.futures(pair).GetPositions(pair, opts...)
+}
+
+// GetUnifiedBalance 获取统一账户资产余额明细
+// 参数:
+//   - currency: 币种，可为空字符串获取所有币种资产
+//
+// 返回值:
+//   - map[string]model.UnifiedBalance: 资产余额，包含全仓杠杆、U本位和币本位合约三部分
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetUnifiedBalance(currency string) (map[string]model.UnifiedBalance, []byte, error) {
+	param := &url.Values{}
+	if currency != "" {
+		param.Set("asset", currency)
+	}
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetAccountUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	balances, err := p.UnmarshalOpts.GetUnifiedBalanceResponseUnmarshaler(wrapArray(data))
+	return balances, data, err
+}
+
+// GetUnifiedAccount 获取统一账户汇总信息
+// 返回值:
+//   - *model.UnifiedAccount: 账户汇总信息，包含uniMMR、账户权益、保证金和账户状态
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetUnifiedAccount() (*model.UnifiedAccount, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.GetUnifiedAccountUri, &url.Values{}, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	acc, err := p.UnmarshalOpts.GetUnifiedAccountResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	return acc, data, nil
+}
+
+// GetUniMMR 获取统一账户维持保证金率
+// 返回值:
+//   - float64: uniMMR，账户权益/维持保证金，低于1.05时触发强平
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetUniMMR() (float64, []byte, error) {
+	acc, data, err := p.GetUnifiedAccount()
+	if err != nil {
+		return 0, data, err
+	}
+	return acc.UniMMR, data, nil
+}
+
+// GetAutoRepayFutures 查询合约负余额自动还款开关
+// 返回值:
+//   - bool: 是否开启自动还款
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) GetAutoRepayFutures() (bool, []byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodGet, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.AutoRepayFuturesUri, &url.Values{}, nil)
+	if err != nil {
+		return false, data, err
+	}
+
+	autoRepay, err := p.UnmarshalOpts.GetAutoRepayFuturesResponseUnmarshaler(data)
+	return autoRepay, data, err
+}
+
+// SetAutoRepayFutures 设置合约负余额自动还款开关
+// 参数:
+//   - autoRepay: 是否开启自动还款
+//
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) SetAutoRepayFutures(autoRepay bool) ([]byte, error) {
+	param := &url.Values{}
+	param.Set("autoRepay", strconv.FormatBool(autoRepay))
+
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.AutoRepayFuturesUri, param, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, fapi.UnmarshalCodeMsgResponse(data)
+}
+
+// RepayFuturesNegativeBalance 使用杠杆账户余额偿还合约负余额
+// 返回值:
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (p *Prv) RepayFuturesNegativeBalance() ([]byte, error) {
+	data, err := p.AuthClient.DoAuthRequest(http.MethodPost, p.AuthClient.UriOpts.Endpoint+p.AuthClient.UriOpts.RepayNegativeBalanceUri, &url.Values{}, nil)
+	if err != nil {
+		return data, err
+	}
+
+	return data, fapi.UnmarshalCodeMsgResponse(data)
+}
+
+// wrapArray 将单个对象包装为数组，统一账户余额接口指定asset时返回单个对象而不是数组
+func wrapArray(data []byte) []byte {
+	if len(data) > 0 && data[0] == '{' {
+		return append(append([]byte{'['}, data...), ']')
+	}
+	return data
+}
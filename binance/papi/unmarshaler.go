@@ -0,0 +1,213 @@
+package papi
+
+import (
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+)
+
+// UnmarshalGetUnifiedBalanceResponse 解析统一账户余额响应数据
+// 参数:
+//   - data: API响应的原始数据(/papi/v1/balance，数组)
+//
+// 返回值:
+//   - map[string]model.UnifiedBalance: 资产余额映射，键为币种名称
+//   - error: 错误信息
+func UnmarshalGetUnifiedBalanceResponse(data []byte) (map[string]model.UnifiedBalance, error) {
+	var balances = make(map[string]model.UnifiedBalance, 8)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var bal model.UnifiedBalance
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				bal.Coin = valStr
+			case "totalWalletBalance":
+				bal.TotalWalletBalance = cast.ToFloat64(valStr)
+			case "crossMarginAsset":
+				bal.CrossMarginAsset = cast.ToFloat64(valStr)
+			case "crossMarginBorrowed":
+				bal.CrossMarginBorrowed = cast.ToFloat64(valStr)
+			case "crossMarginFree":
+				bal.CrossMarginFree = cast.ToFloat64(valStr)
+			case "crossMarginInterest":
+				bal.CrossMarginInterest = cast.ToFloat64(valStr)
+			case "crossMarginLocked":
+				bal.CrossMarginLocked = cast.ToFloat64(valStr)
+			case "umWalletBalance":
+				bal.UmWalletBalance = cast.ToFloat64(valStr)
+			case "umUnrealizedPNL":
+				bal.UmUnrealizedPNL = cast.ToFloat64(valStr)
+			case "cmWalletBalance":
+				bal.CmWalletBalance = cast.ToFloat64(valStr)
+			case "cmUnrealizedPNL":
+				bal.CmUnrealizedPNL = cast.ToFloat64(valStr)
+			case "negativeBalance":
+				bal.NegativeBalance = cast.ToFloat64(valStr)
+			case "updateTime":
+				bal.UpdateTime = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		balances[bal.Coin] = bal
+	})
+	return balances, err
+}
+
+// UnmarshalGetAccountResponse 解析统一账户余额为通用账户资产
+// 参数:
+//   - data: API响应的原始数据(/papi/v1/balance)
+//
+// 返回值:
+//   - map[string]model.Account: 账户资产映射，键为币种名称
+//   - error: 错误信息
+//
+// 注意:
+//   - Balance为钱包余额，AvailableBalance为全仓杠杆可用，FrozenBalance为全仓杠杆冻结
+func UnmarshalGetAccountResponse(data []byte) (map[string]model.Account, error) {
+	balances, err := UnmarshalGetUnifiedBalanceResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts = make(map[string]model.Account, len(balances))
+	for coin, bal := range balances {
+		accounts[coin] = model.Account{
+			Coin:             coin,
+			Balance:          bal.TotalWalletBalance,
+			AvailableBalance: bal.CrossMarginFree,
+			FrozenBalance:    bal.CrossMarginLocked,
+		}
+	}
+
+	return accounts, nil
+}
+
+// UnmarshalGetFuturesAccountResponse 解析统一账户余额为期货账户信息
+// 参数:
+//   - data: API响应的原始数据(/papi/v1/balance)
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 期货账户信息映射，键为币种名称
+//   - error: 错误信息
+//
+// 注意:
+//   - Eq为钱包余额加U本位和币本位合约未实现盈亏，Upl为两者未实现盈亏之和
+//   - AvailEq为全仓杠杆可用，FrozenBal为全仓杠杆冻结
+//   - 统一账户的保证金率按账户计算，见GetUnifiedAccount的UniMMR
+func UnmarshalGetFuturesAccountResponse(data []byte) (map[string]model.FuturesAccount, error) {
+	balances, err := UnmarshalGetUnifiedBalanceResponse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var accounts = make(map[string]model.FuturesAccount, len(balances))
+	for coin, bal := range balances {
+		upl := bal.UmUnrealizedPNL + bal.CmUnrealizedPNL
+		accounts[coin] = model.FuturesAccount{
+			Coin:      coin,
+			Eq:        bal.TotalWalletBalance + upl,
+			AvailEq:   bal.CrossMarginFree,
+			FrozenBal: bal.CrossMarginLocked,
+			Upl:       upl,
+		}
+	}
+
+	return accounts, nil
+}
+
+// UnmarshalGetContractAccountResponse 解析统一账户下U本位/币本位合约账户响应数据
+// 参数:
+//   - data: API响应的原始数据(/papi/v1/um/account或/papi/v1/cm/account)
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 期货账户信息映射，键为币种名称
+//   - error: 错误信息
+//
+// 注意:
+//   - 解析assets数组，Eq为全仓钱包余额+未实现盈亏，FrozenBal为起始保证金占用
+//   - AvailEq为Eq减去起始保证金，MgnRatio为维持保证金/Eq，Eq为0时不计算
+func UnmarshalGetContractAccountResponse(data []byte) (map[string]model.FuturesAccount, error) {
+	var accounts = make(map[string]model.FuturesAccount, 4)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			acc           model.FuturesAccount
+			walletBalance float64
+			maintMargin   float64
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "crossWalletBalance":
+				walletBalance = cast.ToFloat64(valStr)
+			case "crossUnPnl":
+				acc.Upl = cast.ToFloat64(valStr)
+			case "initialMargin":
+				acc.FrozenBal = cast.ToFloat64(valStr)
+			case "maintMargin":
+				maintMargin = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+
+		acc.Eq = walletBalance + acc.Upl
+		acc.AvailEq = acc.Eq - acc.FrozenBal
+		if acc.Eq != 0 {
+			acc.MgnRatio = maintMargin / acc.Eq
+		}
+
+		accounts[acc.Coin] = acc
+	}, "assets")
+	return accounts, err
+}
+
+// UnmarshalGetUnifiedAccountResponse 解析统一账户汇总信息响应数据
+// 参数:
+//   - data: API响应的原始数据(/papi/v1/account)
+//
+// 返回值:
+//   - *model.UnifiedAccount: 统一账户汇总信息，包含uniMMR
+//   - error: 错误信息
+func UnmarshalGetUnifiedAccountResponse(data []byte) (*model.UnifiedAccount, error) {
+	var acc model.UnifiedAccount
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "uniMMR":
+			acc.UniMMR = cast.ToFloat64(valStr)
+		case "accountEquity":
+			acc.AccountEquity = cast.ToFloat64(valStr)
+		case "actualEquity":
+			acc.ActualEquity = cast.ToFloat64(valStr)
+		case "accountInitialMargin":
+			acc.AccountInitialMargin = cast.ToFloat64(valStr)
+		case "accountMaintMargin":
+			acc.AccountMaintMargin = cast.ToFloat64(valStr)
+		case "accountStatus":
+			acc.AccountStatus = valStr
+		case "virtualMaxWithdrawAmount":
+			acc.VirtualMaxWithdrawAmount = cast.ToFloat64(valStr)
+		case "totalAvailableBalance":
+			acc.TotalAvailableBalance = cast.ToFloat64(valStr)
+		case "totalMarginOpenLoss":
+			acc.TotalMarginOpenLoss = cast.ToFloat64(valStr)
+		case "updateTime":
+			acc.UpdateTime = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	return &acc, err
+}
+
+// UnmarshalGetAutoRepayFuturesResponse 解析合约负余额自动还款开关响应数据
+// 参数:
+//   - data: API响应的原始数据，如{"autoRepay": true}
+//
+// 返回值:
+//   - bool: 是否开启自动还款
+//   - error: 错误信息
+func UnmarshalGetAutoRepayFuturesResponse(data []byte) (bool, error) {
+	return jsonparser.GetBoolean(data, "autoRepay")
+}
@@ -61,6 +61,16 @@ func adaptOrderType(ty model.OrderType) string {
 		return "LIMIT"
 	case model.OrderType_Market:
 		return "MARKET"
+	case model.OrderType_StopLossLimit:
+		return "STOP_LOSS_LIMIT"
+	case model.OrderType_TakeProfitLimit:
+		return "TAKE_PROFIT_LIMIT"
+	case model.OrderType_LimitMaker:
+		return "LIMIT_MAKER"
+	case model.OrderType_StopMarket:
+		return "STOP_LOSS"
+	case model.OrderType_TakeProfitMarket:
+		return "TAKE_PROFIT"
 	default:
 		logger.Warnf("[adapt order type] order typ unknown")
 	}
@@ -69,11 +79,11 @@ func adaptOrderType(ty model.OrderType) string {
 
 func adaptOrderStatus(st string) model.OrderStatus {
 	switch st {
-	case "NEW":
+	case "NEW", "PENDING_NEW":
 		return model.OrderStatus_Pending
 	case "FILLED":
 		return model.OrderStatus_Finished
-	case "CANCELED":
+	case "CANCELED", "EXPIRED", "REJECTED":
 		return model.OrderStatus_Canceled
 	case "PARTIALLY_FILLED":
 		return model.OrderStatus_PartFinished
@@ -99,6 +109,16 @@ func adaptOrderOrigType(ty string) model.OrderType {
 		return model.OrderType_Limit
 	case "MARKET":
 		return model.OrderType_Market
+	case "STOP_LOSS_LIMIT":
+		return model.OrderType_StopLossLimit
+	case "TAKE_PROFIT_LIMIT":
+		return model.OrderType_TakeProfitLimit
+	case "LIMIT_MAKER":
+		return model.OrderType_LimitMaker
+	case "STOP_LOSS":
+		return model.OrderType_StopMarket
+	case "TAKE_PROFIT":
+		return model.OrderType_TakeProfitMarket
 	default:
 		return model.OrderType(ty)
 	}
@@ -106,11 +126,11 @@ func adaptOrderOrigType(ty string) model.OrderType {
 
 func adaptOrderOrigStatus(st string) model.OrderStatus {
 	switch st {
-	case "NEW":
+	case "NEW", "PENDING_NEW":
 		return model.OrderStatus_Pending
 	case "FILLED":
 		return model.OrderStatus_Finished
-	case "CANCELED":
+	case "CANCELED", "EXPIRED", "REJECTED":
 		return model.OrderStatus_Canceled
 	case "PARTIALLY_FILLED":
 		return model.OrderStatus_PartFinished
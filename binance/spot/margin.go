@@ -0,0 +1,297 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+)
+
+// MarginPrvApi 币安杠杆(全仓/逐仓)私有API实现
+// 实现IPrvRest，下单、查单、撤单与现货接口一致，可直接替换PrvApi在杠杆账户上运行
+type MarginPrvApi struct {
+	prv      *PrvApi
+	isolated bool
+}
+
+// NewMarginPrvApi 创建币安杠杆私有API实例
+// 参数:
+//   - isolated: true为逐仓杠杆，false为全仓杠杆
+//   - apiOpts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *MarginPrvApi: 币安杠杆私有API实例
+//
+// 注意:
+//   - 使用Spot.MarginUriOpts中的接口地址，逐仓模式下所有请求自动带上isIsolated=TRUE
+//   - 下单时通过opt传入借还款类型，如OptionParameter{}.SideEffectType(SideEffectType_MarginBuy)
+//
+// 使用示例:
+//
+//	marginApi := spot.New().NewMarginPrvApi(false,
+//	  options.WithApiKey("your-api-key"),
+//	  options.WithApiSecretKey("your-secret-key"))
+func (s *Spot) NewMarginPrvApi(isolated bool, apiOpts ...options.ApiOption) *MarginPrvApi {
+	prv := s.NewPrvApi(apiOpts...)
+	prv.AuthClient.UriOpts = s.MarginUriOpts
+	return &MarginPrvApi{prv: prv, isolated: isolated}
+}
+
+// IsIsolated 是否为逐仓杠杆
+func (m *MarginPrvApi) IsIsolated() bool {
+	return m.isolated
+}
+
+// marginOpts 在逐仓模式下追加isIsolated参数
+func (m *MarginPrvApi) marginOpts(opt []OptionParameter) []OptionParameter {
+	if !m.isolated {
+		return opt
+	}
+	return append([]OptionParameter{{Key: "isIsolated", Value: "TRUE"}}, opt...)
+}
+
+// GetAccount 获取杠杆账户资产
+// 参数:
+//   - coin: 币种，为空时返回所有币种
+//
+// 返回值:
+//   - map[string]Account: 账户资产，Balance为可用+冻结
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 逐仓模式下返回所有逐仓交易对的资产按币种合计，单个交易对的资产使用GetIsolatedMarginAccounts
+//   - 借款和净资产使用GetMarginAccount获取
+func (m *MarginPrvApi) GetAccount(coin string) (map[string]Account, []byte, error) {
+	var (
+		assets []MarginAsset
+		data   []byte
+	)
+
+	if m.isolated {
+		accounts, body, err := m.GetIsolatedMarginAccounts()
+		if err != nil {
+			return nil, body, err
+		}
+		data = body
+		for _, acc := range accounts {
+			assets = append(assets, acc.BaseAsset, acc.QuoteAsset)
+		}
+	} else {
+		acc, body, err := m.GetMarginAccount()
+		if err != nil {
+			return nil, body, err
+		}
+		data = body
+		for _, asset := range acc.Assets {
+			assets = append(assets, asset)
+		}
+	}
+
+	var accounts = make(map[string]Account, len(assets))
+	for _, asset := range assets {
+		if coin != "" && asset.Coin != coin {
+			continue
+		}
+		acc := accounts[asset.Coin]
+		acc.Coin = asset.Coin
+		acc.AvailableBalance += asset.Free
+		acc.FrozenBalance += asset.Locked
+		acc.Balance = acc.AvailableBalance + acc.FrozenBalance
+		accounts[asset.Coin] = acc
+	}
+
+	return accounts, data, nil
+}
+
+// CreateOrder 创建杠杆订单
+// 参数:
+//   - pair: 交易对信息
+//   - qty: 交易数量
+//   - price: 交易价格
+//   - side: 交易方向，买入或卖出
+//   - orderTy: 订单类型
+//   - opt: 可选参数，如OptionParameter{}.SideEffectType(SideEffectType_AutoRepay)
+//
+// 返回值:
+//   - *Order: 订单信息
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (m *MarginPrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side OrderSide, orderTy OrderType, opt ...OptionParameter) (*Order, []byte, error) {
+	return m.prv.CreateOrder(pair, qty, price, side, orderTy, m.marginOpts(opt)...)
+}
+
+// GetOrderInfo 获取杠杆订单信息
+func (m *MarginPrvApi) GetOrderInfo(pair CurrencyPair, id string, opt ...OptionParameter) (*Order, []byte, error) {
+	return m.prv.GetOrderInfo(pair, id, m.marginOpts(opt)...)
+}
+
+// GetPendingOrders 获取杠杆当前未完成的订单
+func (m *MarginPrvApi) GetPendingOrders(pair CurrencyPair, opt ...OptionParameter) ([]Order, []byte, error) {
+	return m.prv.GetPendingOrders(pair, m.marginOpts(opt)...)
+}
+
+// GetHistoryOrders 获取杠杆历史订单
+func (m *MarginPrvApi) GetHistoryOrders(pair CurrencyPair, opt ...OptionParameter) ([]Order, []byte, error) {
+	return m.prv.GetHistoryOrders(pair, m.marginOpts(opt)...)
+}
+
+// CancelOrder 取消杠杆订单
+func (m *MarginPrvApi) CancelOrder(pair CurrencyPair, id string, opt ...OptionParameter) ([]byte, error) {
+	return m.prv.CancelOrder(pair, id, m.marginOpts(opt)...)
+}
+
+// GetFills 获取杠杆成交明细，翻页规则与PrvApi.GetFills一致
+func (m *MarginPrvApi) GetFills(pair CurrencyPair, opts ...OptionParameter) ([]Fill, []byte, error) {
+	return m.prv.GetFills(pair, m.marginOpts(opts)...)
+}
+
+// Borrow 杠杆借款
+// 参数:
+//   - pair: 逐仓交易对，全仓模式下可传CurrencyPair{}
+//   - coin: 借款币种
+//   - amount: 借款数量
+//
+// 返回值:
+//   - string: 交易ID
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (m *MarginPrvApi) Borrow(pair CurrencyPair, coin string, amount float64, opt ...OptionParameter) (string, []byte, error) {
+	return m.borrowRepay("BORROW", pair, coin, amount, opt...)
+}
+
+// Repay 杠杆还款
+// 参数:
+//   - pair: 逐仓交易对，全仓模式下可传CurrencyPair{}
+//   - coin: 还款币种
+//   - amount: 还款数量
+//
+// 返回值:
+//   - string: 交易ID
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (m *MarginPrvApi) Repay(pair CurrencyPair, coin string, amount float64, opt ...OptionParameter) (string, []byte, error) {
+	return m.borrowRepay("REPAY", pair, coin, amount, opt...)
+}
+
+func (m *MarginPrvApi) borrowRepay(ty string, pair CurrencyPair, coin string, amount float64, opt ...OptionParameter) (string, []byte, error) {
+	if m.isolated && pair.Symbol == "" {
+		return "", nil, errors.New("isolated margin requires pair")
+	}
+
+	params := url.Values{}
+	params.Set("asset", coin)
+	params.Set("amount", FloatToString(amount, 8))
+	params.Set("type", ty)
+	params.Set("isIsolated", "FALSE")
+	if m.isolated {
+		params.Set("isIsolated", "TRUE")
+		params.Set("symbol", pair.Symbol)
+	}
+	MergeOptionParams(&params, opt...)
+
+	reqUrl := fmt.Sprintf("%s%s", m.prv.AuthClient.UriOpts.Endpoint, m.prv.AuthClient.UriOpts.BorrowRepayUri)
+	data, err := m.prv.AuthClient.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return "", data, err
+	}
+	tranId, err := m.prv.UnmarshalerOpts.BorrowRepayResponseUnmarshaler(data)
+	return tranId, data, err
+}
+
+// GetMaxBorrowable 查询最大可借数量
+// 参数:
+//   - pair: 逐仓交易对，全仓模式下可传CurrencyPair{}
+//   - coin: 借款币种
+//
+// 返回值:
+//   - float64: 最大可借数量
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (m *MarginPrvApi) GetMaxBorrowable(pair CurrencyPair, coin string, opt ...OptionParameter) (float64, []byte, error) {
+	params := url.Values{}
+	params.Set("asset", coin)
+	if m.isolated {
+		params.Set("isolatedSymbol", pair.Symbol)
+	}
+	MergeOptionParams(&params, opt...)
+
+	reqUrl := fmt.Sprintf("%s%s", m.prv.AuthClient.UriOpts.Endpoint, m.prv.AuthClient.UriOpts.GetMaxBorrowableUri)
+	data, err := m.prv.AuthClient.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return 0, data, err
+	}
+	amount, err := m.prv.UnmarshalerOpts.GetMaxBorrowableResponseUnmarshaler(data)
+	return amount, data, err
+}
+
+// GetMarginAccount 获取全仓杠杆账户详情，包括风险率、借款、利息和净资产
+func (m *MarginPrvApi) GetMarginAccount() (*MarginAccount, []byte, error) {
+	params := url.Values{}
+	reqUrl := fmt.Sprintf("%s%s", m.prv.AuthClient.UriOpts.Endpoint, m.prv.AuthClient.UriOpts.GetAccountUri)
+	data, err := m.prv.AuthClient.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+	acc, err := m.prv.UnmarshalerOpts.GetMarginAccountResponseUnmarshaler(data)
+	return acc, data, err
+}
+
+// GetIsolatedMarginAccounts 获取逐仓杠杆交易对账户
+// 参数:
+//   - pairs: 交易对，最多5个，为空时返回所有逐仓交易对
+//
+// 返回值:
+//   - map[string]IsolatedMarginAccount: 逐仓账户，键为交易对symbol
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (m *MarginPrvApi) GetIsolatedMarginAccounts(pairs ...CurrencyPair) (map[string]IsolatedMarginAccount, []byte, error) {
+	params := url.Values{}
+	if len(pairs) > 0 {
+		symbols := pairs[0].Symbol
+		for _, pair := range pairs[1:] {
+			symbols += "," + pair.Symbol
+		}
+		params.Set("symbols", symbols)
+	}
+
+	reqUrl := fmt.Sprintf("%s%s", m.prv.AuthClient.UriOpts.Endpoint, m.prv.AuthClient.UriOpts.GetIsolatedAccountUri)
+	data, err := m.prv.AuthClient.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+	accounts, err := m.prv.UnmarshalerOpts.GetIsolatedAccountResponseUnmarshaler(data)
+	return accounts, data, err
+}
+
+// GetInterestHistory 获取杠杆利息历史
+// 参数:
+//   - coin: 币种，为空时返回所有币种
+//   - opt: 可选参数，如isolatedSymbol、startTime、endTime、current、size
+//
+// 返回值:
+//   - []MarginInterest: 利息记录
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认每页返回100条，通过current参数翻页
+func (m *MarginPrvApi) GetInterestHistory(coin string, opt ...OptionParameter) ([]MarginInterest, []byte, error) {
+	params := url.Values{}
+	if coin != "" {
+		params.Set("asset", coin)
+	}
+	params.Set("size", "100")
+	MergeOptionParams(&params, opt...)
+
+	reqUrl := fmt.Sprintf("%s%s", m.prv.AuthClient.UriOpts.Endpoint, m.prv.AuthClient.UriOpts.GetInterestHistoryUri)
+	data, err := m.prv.AuthClient.DoAuthRequest(http.MethodGet, reqUrl, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+	interests, err := m.prv.UnmarshalerOpts.GetInterestHistoryResponseUnmarshaler(data)
+	return interests, data, err
+}
@@ -0,0 +1,321 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CreateOCOOrder 创建OCO订单(One-Cancels-the-Other)，两个订单其中一个成交或触发后另一个自动撤销
+// 参数:
+//   - pair: 交易对信息
+//   - above: 价格较高的订单，卖出时一般为止盈单(OrderType_LimitMaker)
+//   - below: 价格较低的订单，卖出时一般为止损单(OrderType_StopLossLimit/OrderType_StopMarket)
+//   - opt: 可选参数，如OptionParameter{}.OrderClientID(listClientOrderId)
+//
+// 返回值:
+//   - *OrderList: 订单列表，包含两个订单的信息
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 两个订单的方向和数量必须一致，以above为准
+//   - 买入时above一般为止损单(OrderType_StopMarket)，below为限价单(OrderType_LimitMaker)
+//   - 按交易对的Filters规整各订单的数量、价格和触发价格，违反交易规则时直接返回错误
+//   - 需要API密钥交易权限
+//
+// 使用示例:
+//
+//	// 持有BTC，止盈70000，跌破60000止损
+//	list, _, err := prvApi.CreateOCOOrder(pair,
+//	  OrderListLeg{Side: Spot_Sell, OrderTy: OrderType_LimitMaker, Qty: 0.01, Price: 70000},
+//	  OrderListLeg{Side: Spot_Sell, OrderTy: OrderType_StopLossLimit, Qty: 0.01, Price: 59900, StopPrice: 60000})
+func (s *PrvApi) CreateOCOOrder(pair CurrencyPair, above, below OrderListLeg, opt ...OptionParameter) (*OrderList, []byte, error) {
+	if above.Side != below.Side || above.Qty != below.Qty {
+		return nil, nil, errors.New("the side and qty of the above and below orders must be the same")
+	}
+
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("side", adaptOrderSide(above.Side))
+
+	qty, err := setOrderListLegParams(&params, "above", pair, above)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = setOrderListLegParams(&params, "below", pair, below); err != nil {
+		return nil, nil, err
+	}
+	params.Set("quantity", qty)
+
+	return s.createOrderList(pair, s.AuthClient.UriOpts.NewOCOOrderUri, &params, opt...)
+}
+
+// CreateOTOOrder 创建OTO订单(One-Triggers-the-Other)，working订单成交后才会挂出pending订单
+// 参数:
+//   - pair: 交易对信息
+//   - working: 先挂出的订单，只能是限价类订单
+//   - pending: working订单完全成交后挂出的订单
+//   - opt: 可选参数，如OptionParameter{}.OrderClientID(listClientOrderId)
+//
+// 返回值:
+//   - *OrderList: 订单列表，包含两个订单的信息
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 按交易对的Filters规整各订单的数量、价格和触发价格，违反交易规则时直接返回错误
+//   - 需要API密钥交易权限
+func (s *PrvApi) CreateOTOOrder(pair CurrencyPair, working, pending OrderListLeg, opt ...OptionParameter) (*OrderList, []byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	workingQty, err := setOrderListLegParams(&params, "working", pair, working)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Set("workingSide", adaptOrderSide(working.Side))
+	params.Set("workingQuantity", workingQty)
+
+	pendingQty, err := setOrderListLegParams(&params, "pending", pair, pending)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Set("pendingSide", adaptOrderSide(pending.Side))
+	params.Set("pendingQuantity", pendingQty)
+
+	return s.createOrderList(pair, s.AuthClient.UriOpts.NewOTOOrderUri, &params, opt...)
+}
+
+// CreateOTOCOOrder 创建OTOCO订单，working订单成交后挂出一组OCO订单，即带止盈止损的开仓单
+// 参数:
+//   - pair: 交易对信息
+//   - working: 先挂出的订单，只能是限价类订单
+//   - pendingAbove: working订单成交后挂出的价格较高的订单
+//   - pendingBelow: working订单成交后挂出的价格较低的订单
+//   - opt: 可选参数，如OptionParameter{}.OrderClientID(listClientOrderId)
+//
+// 返回值:
+//   - *OrderList: 订单列表，包含三个订单的信息
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - pendingAbove和pendingBelow的方向和数量必须一致，以pendingAbove为准
+//   - 按交易对的Filters规整各订单的数量、价格和触发价格，违反交易规则时直接返回错误
+//   - 需要API密钥交易权限
+//
+// 使用示例:
+//
+//	// 65000买入，成交后止盈70000，跌破60000止损
+//	list, _, err := prvApi.CreateOTOCOOrder(pair,
+//	  OrderListLeg{Side: Spot_Buy, OrderTy: OrderType_Limit, Qty: 0.01, Price: 65000},
+//	  OrderListLeg{Side: Spot_Sell, OrderTy: OrderType_LimitMaker, Qty: 0.01, Price: 70000},
+//	  OrderListLeg{Side: Spot_Sell, OrderTy: OrderType_StopMarket, Qty: 0.01, StopPrice: 60000})
+func (s *PrvApi) CreateOTOCOOrder(pair CurrencyPair, working, pendingAbove, pendingBelow OrderListLeg, opt ...OptionParameter) (*OrderList, []byte, error) {
+	if pendingAbove.Side != pendingBelow.Side || pendingAbove.Qty != pendingBelow.Qty {
+		return nil, nil, errors.New("the side and qty of the pending above and below orders must be the same")
+	}
+
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	workingQty, err := setOrderListLegParams(&params, "working", pair, working)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Set("workingSide", adaptOrderSide(working.Side))
+	params.Set("workingQuantity", workingQty)
+
+	pendingQty, err := setOrderListLegParams(&params, "pendingAbove", pair, pendingAbove)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = setOrderListLegParams(&params, "pendingBelow", pair, pendingBelow); err != nil {
+		return nil, nil, err
+	}
+	params.Set("pendingSide", adaptOrderSide(pendingAbove.Side))
+	params.Set("pendingQuantity", pendingQty)
+
+	return s.createOrderList(pair, s.AuthClient.UriOpts.NewOTOCOOrderUri, &params, opt...)
+}
+
+func (s *PrvApi) createOrderList(pair CurrencyPair, uri string, params *url.Values, opt ...OptionParameter) (*OrderList, []byte, error) {
+	params.Set("newOrderRespType", "RESULT")
+
+	MergeOptionParams(params, opt...)
+	adaptListClientOrderId(params, "listClientOrderId")
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, uri), params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	list, err := s.UnmarshalerOpts.OrderListResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fillOrderListPair(list, pair)
+
+	return list, data, nil
+}
+
+// GetOrderList 查询订单列表
+// 参数:
+//   - pair: 交易对信息
+//   - id: orderListId
+//   - opt: 可选参数，id为空时可以通过OptionParameter{}.OrderClientID传入listClientOrderId
+//
+// 返回值:
+//   - *OrderList: 订单列表，只包含各订单的Id和CId，订单详情需要通过GetOrderInfo查询
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (s *PrvApi) GetOrderList(pair CurrencyPair, id string, opt ...OptionParameter) (*OrderList, []byte, error) {
+	var params = url.Values{}
+	if id != "" {
+		params.Set("orderListId", id)
+	}
+
+	MergeOptionParams(&params, opt...)
+	adaptListClientOrderId(&params, "origClientOrderId")
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.OrderListUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	list, err := s.UnmarshalerOpts.OrderListResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fillOrderListPair(list, pair)
+
+	return list, data, nil
+}
+
+// GetPendingOrderLists 查询所有执行中的订单列表
+// 参数:
+//   - opt: 可选参数
+//
+// 返回值:
+//   - []OrderList: 订单列表，Pair只有Symbol
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (s *PrvApi) GetPendingOrderLists(opt ...OptionParameter) ([]OrderList, []byte, error) {
+	var params = url.Values{}
+	MergeOptionParams(&params, opt...)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.OpenOrderListUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	lists, err := s.UnmarshalerOpts.GetOrderListsResponseUnmarshaler(data)
+	return lists, data, err
+}
+
+// CancelOrderList 撤销整个订单列表
+// 参数:
+//   - pair: 交易对信息
+//   - id: orderListId
+//   - opt: 可选参数，id为空时可以通过OptionParameter{}.OrderClientID传入listClientOrderId
+//
+// 返回值:
+//   - *OrderList: 撤销后的订单列表，包含各订单的最新状态
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 撤销订单列表中的任意一个订单(CancelOrder)也会撤销整个订单列表
+func (s *PrvApi) CancelOrderList(pair CurrencyPair, id string, opt ...OptionParameter) (*OrderList, []byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	if id != "" {
+		params.Set("orderListId", id)
+	}
+
+	MergeOptionParams(&params, opt...)
+	adaptListClientOrderId(&params, "listClientOrderId")
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodDelete,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.OrderListUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	list, err := s.UnmarshalerOpts.OrderListResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	fillOrderListPair(list, pair)
+
+	return list, data, nil
+}
+
+// setOrderListLegParams 设置订单列表中单个订单的参数，参数名为prefix加上字段名，如aboveType、workingPrice
+// 与CreateOrder一样按交易对的Filters规整数量、价格和触发价格，返回规整后的数量，数量的参数名由调用方设置
+func setOrderListLegParams(params *url.Values, prefix string, pair CurrencyPair, leg OrderListLeg) (string, error) {
+	key := func(name string) string {
+		return prefix + name
+	}
+
+	qty, price, err := common.NormalizeOrder(pair, leg.Qty, leg.Price, leg.Side, leg.OrderTy, 0)
+	if err != nil {
+		return "", fmt.Errorf("%s order: %w", prefix, err)
+	}
+
+	params.Set(key("Type"), adaptOrderType(leg.OrderTy))
+
+	if common.IsPricedOrderType(leg.OrderTy) {
+		params.Set(key("Price"), FloatToString(price, pair.PricePrecision))
+	}
+
+	if common.IsLimitOrderType(leg.OrderTy) {
+		tif := leg.TimeInForce
+		if tif == "" {
+			tif = "GTC"
+		}
+		params.Set(key("TimeInForce"), strings.ToUpper(tif))
+	}
+
+	if leg.StopPrice > 0 {
+		stopPrice, err := common.NormalizePrice(pair, leg.StopPrice)
+		if err != nil {
+			return "", fmt.Errorf("%s order: %w", prefix, err)
+		}
+		params.Set(key("StopPrice"), FloatToString(stopPrice, pair.PricePrecision))
+	}
+
+	if leg.CId != "" {
+		params.Set(key("ClientOrderId"), leg.CId)
+	}
+
+	return FloatToString(qty, pair.QtyPrecision), nil
+}
+
+// adaptListClientOrderId 将Order_Client_ID__Opt_Key转换为订单列表的自定义ID参数
+func adaptListClientOrderId(params *url.Values, key string) {
+	cid := params.Get(Order_Client_ID__Opt_Key)
+	if cid != "" {
+		params.Set(key, cid)
+		params.Del(Order_Client_ID__Opt_Key)
+	}
+}
+
+func fillOrderListPair(list *OrderList, pair CurrencyPair) {
+	list.Pair = pair
+	for i := range list.Orders {
+		list.Orders[i].Pair = pair
+	}
+}
@@ -1,13 +1,17 @@
 package spot
 
 import (
+	"errors"
 	"fmt"
 	"github.com/nntaoli-project/goex/v2/binance/common"
 	. "github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/options"
 	. "github.com/nntaoli-project/goex/v2/util"
+	"github.com/spf13/cast"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
 )
 
 // PrvApi 币安现货私有API实现
@@ -15,6 +19,8 @@ import (
 type PrvApi struct {
 	*Spot
 	*common.AuthClient
+
+	testMode bool //测试下单模式，开启后CreateOrder只校验不下单
 }
 
 // NewPrvApi 创建币安现货私有API实例
@@ -78,39 +84,261 @@ func (s *PrvApi) GetAccount(coin string) (map[string]Account, []byte, error) {
 //   - error: 错误信息
 //
 // 注意:
-//   - 默认使用GTC(Good Till Cancel)时效策略
+//   - 限价类订单(LIMIT/STOP_LOSS_LIMIT/TAKE_PROFIT_LIMIT)默认使用GTC(Good Till Cancel)时效策略，LIMIT_MAKER只发送价格，市价单忽略price
+//   - 止损/止盈限价单需要通过opt传入触发价格，如OptionParameter{}.StopPrice(29000)
 //   - 可以通过opt参数传入clientOrderId来指定客户端订单ID
 //   - 需要API密钥交易权限
+//   - 下单前按交易对的Filters规整价格(tickSize)和数量(stepSize)，违反交易规则时直接返回错误，不请求交易所
+//   - 测试下单模式(见WithTestMode)或传入OptionParameter{}.TestOrder(true)时只校验不下单，返回的订单没有订单ID和状态
 func (s *PrvApi) CreateOrder(pair CurrencyPair, qty, price float64, side OrderSide, orderTy OrderType, opt ...OptionParameter) (*Order, []byte, error) {
+	params, err := s.buildCreateOrderParams(pair, qty, price, side, orderTy, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if common.IsTestOrder(s.testMode, opt...) {
+		data, err := s.doTestOrder(&params)
+		if err != nil {
+			return nil, data, err
+		}
+		ord := &Order{Pair: pair, Side: side, OrderTy: orderTy, CId: params.Get("newClientOrderId")}
+		fillOrderFieldsFromParams(ord, params)
+		return ord, data, nil
+	}
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.NewOrderUri), &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := s.UnmarshalerOpts.CreateOrderResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord.Pair = pair
+	ord.Status = OrderStatus_Pending
+	ord.Side = side
+	ord.OrderTy = orderTy
+	fillOrderFieldsFromParams(ord, params)
+
+	return ord, data, nil
+}
+
+// fillOrderFieldsFromParams 使用实际发送的下单参数填充订单的数量、价格和触发价格
+// 数量和价格为按stepSize/tickSize规整后的值，与交易所收到的一致，未发送时为0
+func fillOrderFieldsFromParams(ord *Order, params url.Values) {
+	ord.Qty = cast.ToFloat64(params.Get("quantity"))
+	ord.Price = cast.ToFloat64(params.Get("price"))
+	ord.StopPrice = cast.ToFloat64(params.Get(Stop_Price__Opt_Key))
+}
+
+// buildCreateOrderParams 构造下单参数，CreateOrder和CreateTestOrder共用
+func (s *PrvApi) buildCreateOrderParams(pair CurrencyPair, qty, price float64, side OrderSide, orderTy OrderType, opt ...OptionParameter) (url.Values, error) {
+	qty, price, err := common.NormalizeOrder(pair, qty, price, side, orderTy, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	var params = url.Values{}
 	params.Set("symbol", pair.Symbol)
 	params.Set("side", adaptOrderSide(side))
 	params.Set("type", adaptOrderType(orderTy))
-	params.Set("timeInForce", "GTC")
 	params.Set("quantity", FloatToString(qty, pair.QtyPrecision))
-	params.Set("price", FloatToString(price, pair.PricePrecision))
 	params.Set("newOrderRespType", "ACK")
 
+	if common.IsPricedOrderType(orderTy) {
+		params.Set("price", FloatToString(price, pair.PricePrecision))
+	}
+	if common.IsLimitOrderType(orderTy) {
+		params.Set("timeInForce", "GTC")
+	}
+
 	MergeOptionParams(&params, opt...)
 	common.AdaptOrderClientIDOptionParameter(&params)
+	params.Del(Test_Order__Opt_Key) //只用于选择下单接口，不发送给交易所
 
-	data, err := s.AuthClient.DoAuthRequest(http.MethodPost,
-		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.NewOrderUri), &params, nil)
+	if err = common.NormalizeExactOrderParams(pair, &params, side, orderTy, opt...); err != nil {
+		return nil, err
+	}
+
+	if stopPrice := params.Get(Stop_Price__Opt_Key); stopPrice != "" {
+		sp, err := common.NormalizePrice(pair, cast.ToFloat64(stopPrice))
+		if err != nil {
+			return nil, err
+		}
+		params.Set(Stop_Price__Opt_Key, FloatToString(sp, pair.PricePrecision))
+	}
+
+	return params, nil
+}
+
+// WithTestMode 开启或关闭测试下单模式
+// 参数:
+//   - enabled: 是否开启
+//
+// 返回值:
+//   - *PrvApi: 当前实例，便于链式调用
+//
+// 注意:
+//   - 开启后CreateOrder/CreateOrders使用/api/v3/order/test，只校验参数、签名和交易规则，不会真正下单，返回的订单没有订单ID
+//   - 单次下单可以通过OptionParameter{}.TestOrder(true/false)覆盖实例的设置
+//   - 杠杆账户没有测试下单接口，MarginPrvApi下单传入TestOrder(true)会返回错误
+//
+// 使用示例:
+//
+//	prvApi := spot.NewPrvApi(options.WithApiKey("key"), options.WithApiSecretKey("secret")).WithTestMode(true)
+func (s *PrvApi) WithTestMode(enabled bool) *PrvApi {
+	s.testMode = enabled
+	return s
+}
+
+// CreateTestOrder 测试下单并返回该订单适用的手续费率
+// 参数:
+//   - pair: 交易对信息
+//   - qty: 交易数量
+//   - price: 交易价格
+//   - side: 交易方向，买入或卖出
+//   - orderTy: 订单类型，如限价单、市价单等
+//   - opt: 可选参数，与CreateOrder相同
+//
+// 返回值:
+//   - *CommissionRates: 标准费率、税费率及抵扣资产折扣
+//   - []byte: 原始响应数据
+//   - error: 参数、签名或交易规则校验失败时的错误信息
+//
+// 注意:
+//   - 无论是否开启测试模式都使用/api/v3/order/test，不会真正下单
+func (s *PrvApi) CreateTestOrder(pair CurrencyPair, qty, price float64, side OrderSide, orderTy OrderType, opt ...OptionParameter) (*CommissionRates, []byte, error) {
+	params, err := s.buildCreateOrderParams(pair, qty, price, side, orderTy, opt...)
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Set(Compute_Commission_Rates__Opt_Key, "true")
+
+	data, err := s.doTestOrder(&params)
 	if err != nil {
 		return nil, data, err
 	}
 
-	ord, err := s.UnmarshalerOpts.CreateOrderResponseUnmarshaler(data)
+	rates, err := s.UnmarshalerOpts.TestOrderResponseUnmarshaler(data)
+	return rates, data, err
+}
+
+// doTestOrder 请求测试下单接口
+func (s *PrvApi) doTestOrder(params *url.Values) ([]byte, error) {
+	if s.AuthClient.UriOpts.TestOrderUri == "" {
+		return nil, errors.New("test order is not supported")
+	}
+	return s.AuthClient.DoAuthRequest(http.MethodPost,
+		fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.TestOrderUri), params, nil)
+}
+
+// AmendOrder 修改未成交订单的数量和价格
+// 参数:
+//   - pair: 交易对信息
+//   - id: 原订单ID，为空时可以通过OptionParameter{}.OrigOrderClientID传入原订单的clientOrderId
+//   - newQty: 新的数量
+//   - newPrice: 新的价格
+//   - opt: 可选参数，如side、type、timeInForce等，OptionParameter{}.OrderClientID为新订单的clientOrderId
+//
+// 返回值:
+//   - *Order: 新订单信息
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 通过/api/v3/order/cancelReplace撤销原订单并下新单，新订单ID与原订单不同
+//   - 使用STOP_ON_FAILURE模式，撤单失败时不会下新单
+//   - opt中未传入side或type时会先查询原订单获取，止损/止盈单未传入stopPrice时同样使用原订单的触发价格
+//   - 按新订单的类型和交易对的Filters规整价格和数量，违反交易规则时直接返回错误，市价类订单不发送price
+//   - 需要API密钥交易权限
+func (s *PrvApi) AmendOrder(pair CurrencyPair, id string, newQty, newPrice float64, opt ...OptionParameter) (*Order, []byte, error) {
+	var params = url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("cancelReplaceMode", "STOP_ON_FAILURE")
+	params.Set("newOrderRespType", "RESULT")
+	if id != "" {
+		params.Set("cancelOrderId", id)
+	}
+
+	MergeOptionParams(&params, opt...)
+	common.AdaptOrderClientIDOptionParameter(&params) //新订单的clientOrderId
+
+	origCid := params.Get(Orig_Order_Client_ID__Opt_Key)
+	if origCid != "" {
+		params.Set("cancelOrigClientOrderId", origCid)
+		params.Del(Orig_Order_Client_ID__Opt_Key)
+	}
+
+	needStopPrice := func() bool { //止损/止盈单必须传入stopPrice，否则新订单会被拒绝
+		switch adaptOrderOrigType(params.Get("type")) {
+		case OrderType_StopLossLimit, OrderType_TakeProfitLimit, OrderType_StopMarket, OrderType_TakeProfitMarket:
+			return params.Get(Stop_Price__Opt_Key) == ""
+		}
+		return false
+	}
+
+	if params.Get("side") == "" || params.Get("type") == "" || needStopPrice() {
+		var queryOpts []OptionParameter
+		if origCid != "" {
+			queryOpts = append(queryOpts, OptionParameter{}.OrderClientID(origCid))
+		}
+
+		ord, body, err := s.GetOrderInfo(pair, id, queryOpts...)
+		if err != nil {
+			return nil, body, fmt.Errorf("query order error: %w", err)
+		}
+
+		if params.Get("side") == "" {
+			params.Set("side", adaptOrderSide(ord.Side))
+		}
+		if params.Get("type") == "" {
+			params.Set("type", adaptOrderType(ord.OrderTy))
+		}
+		if needStopPrice() && ord.StopPrice > 0 {
+			params.Set(Stop_Price__Opt_Key, FloatToString(ord.StopPrice, pair.PricePrecision))
+		}
+	}
+
+	orderTy := adaptOrderOrigType(params.Get("type"))
+	qty, price, err := common.NormalizeOrder(pair, newQty, newPrice, "", orderTy, 0) //refPrice为0时不使用side
+	if err != nil {
+		return nil, nil, err
+	}
+	params.Set("quantity", FloatToString(qty, pair.QtyPrecision))
+	if common.IsPricedOrderType(orderTy) {
+		params.Set("price", FloatToString(price, pair.PricePrecision))
+	}
+	if err = common.NormalizeExactOrderParams(pair, &params, "", orderTy, opt...); err != nil {
+		return nil, nil, err
+	}
+
+	if common.IsLimitOrderType(orderTy) && params.Get("timeInForce") == "" {
+		params.Set("timeInForce", "GTC")
+	}
+
+	if stopPrice := params.Get(Stop_Price__Opt_Key); stopPrice != "" {
+		sp, err := common.NormalizePrice(pair, cast.ToFloat64(stopPrice))
+		if err != nil {
+			return nil, nil, err
+		}
+		params.Set(Stop_Price__Opt_Key, FloatToString(sp, pair.PricePrecision))
+	}
+
+	reqUrl := fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.AmendOrderUri)
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost, reqUrl, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	ord, err := s.UnmarshalerOpts.AmendOrderResponseUnmarshaler(data)
 	if err != nil {
 		return nil, data, err
 	}
 
 	ord.Pair = pair
-	ord.Price = price
-	ord.Qty = qty
-	ord.Status = OrderStatus_Pending
-	ord.Side = side
-	ord.OrderTy = orderTy
 
 	return ord, data, nil
 }
@@ -236,3 +464,123 @@ func (s *PrvApi) CancelOrder(pair CurrencyPair, id string, opt ...OptionParamete
 	}
 	return data, s.UnmarshalerOpts.CancelOrderResponseUnmarshaler(data)
 }
+
+// batchConcurrency 现货批量下单/撤单的最大并发请求数
+const batchConcurrency = 10
+
+// CreateOrders 批量下单
+// 参数:
+//   - reqs: 订单参数列表，可以包含不同交易对
+//
+// 返回值:
+//   - []BatchOrderResult: 与reqs一一对应的下单结果
+//   - error: 整体性错误，如reqs为空
+//
+// 注意:
+//   - 现货没有批量下单接口，内部并发调用CreateOrder，最多同时10个请求
+//   - 单个订单失败时错误记录在对应结果的Err中，不影响其他订单
+func (s *PrvApi) CreateOrders(reqs []BatchOrderReq) ([]BatchOrderResult, error) {
+	if len(reqs) == 0 {
+		return nil, errors.New("empty batch order requests")
+	}
+
+	results := make([]BatchOrderResult, len(reqs))
+	fanOut(len(reqs), func(i int) {
+		req := reqs[i]
+		ord, _, err := s.CreateOrder(req.Pair, req.Qty, req.Price, req.Side, req.OrderTy, req.Opts...)
+		results[i] = BatchOrderResult{Order: ord, Err: err}
+	})
+
+	return results, nil
+}
+
+// CancelOrders 批量撤单
+// 参数:
+//   - pair: 交易对信息
+//   - ids: 订单ID列表
+//   - opt: 可选参数，会附加到每个撤单请求
+//
+// 返回值:
+//   - []BatchOrderResult: 与ids一一对应的撤单结果，成功时Order只包含交易对、订单ID和撤销状态
+//   - error: 整体性错误，如ids为空
+//
+// 注意:
+//   - 内部并发调用CancelOrder，最多同时10个请求
+func (s *PrvApi) CancelOrders(pair CurrencyPair, ids []string, opt ...OptionParameter) ([]BatchOrderResult, error) {
+	if len(ids) == 0 {
+		return nil, errors.New("empty order ids")
+	}
+
+	results := make([]BatchOrderResult, len(ids))
+	fanOut(len(ids), func(i int) {
+		_, err := s.CancelOrder(pair, ids[i], opt...)
+		if err != nil {
+			results[i].Err = err
+			return
+		}
+		results[i].Order = &Order{Pair: pair, Id: ids[i], Status: OrderStatus_Canceled}
+	})
+
+	return results, nil
+}
+
+// fanOut 以最多batchConcurrency个并发执行fn(0..n-1)，全部完成后返回
+func fanOut(n int, fn func(i int)) {
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, batchConcurrency)
+	)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+const (
+	fillPageSize = 1000                        //成交明细接口单次最多返回1000条
+	fillWindow   = int64(24 * time.Hour / 1e6) //成交明细接口单次查询的最大时间跨度(毫秒)
+)
+
+// GetFills 获取账户成交明细
+// 参数:
+//   - pair: 交易对信息
+//   - opts: 可选参数，支持fromId、startTime、endTime(毫秒时间戳)、orderId
+//
+// 返回值:
+//   - []Fill: 成交明细列表，按成交ID升序
+//   - []byte: 最后一次请求的原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 传入fromId时从该成交ID开始自动翻页直到最新成交
+//   - 传入startTime时按24小时窗口切分[startTime, endTime]，窗口内按时间自动翻页，endTime默认为当前时间
+//   - 都不传时只请求一次，返回最近的成交
+//   - 需要API密钥权限
+func (s *PrvApi) GetFills(pair CurrencyPair, opts ...OptionParameter) ([]Fill, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	params.Set("limit", fmt.Sprint(fillPageSize))
+	MergeOptionParams(&params, opts...)
+
+	return common.PageFills(&params, fillWindow, s.getFillsPage)
+}
+
+func (s *PrvApi) getFillsPage(params *url.Values) ([]Fill, []byte, error) {
+	reqUrl := fmt.Sprintf("%s%s", s.AuthClient.UriOpts.Endpoint, s.AuthClient.UriOpts.GetFillsUri)
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, reqUrl, params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+	fills, err := s.UnmarshalerOpts.GetFillsResponseUnmarshaler(data)
+	return fills, data, err
+}
@@ -0,0 +1,209 @@
+package spot
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/spf13/cast"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stubHttpClient 替换httpcli.Cli，按请求返回预设的响应
+type stubHttpClient struct {
+	handler func(method, reqUrl string) ([]byte, error)
+}
+
+func (c *stubHttpClient) SetTimeout(sec int64)         {}
+func (c *stubHttpClient) SetProxy(proxy string) error  { return nil }
+func (c *stubHttpClient) SetHeaders(key, value string) {}
+func (c *stubHttpClient) DoRequest(method, rqUrl string, reqBody string, headers map[string]string) ([]byte, error) {
+	return c.handler(method, rqUrl)
+}
+
+// withStubHttpClient 在测试期间使用stub替换httpcli.Cli，测试结束后恢复
+func withStubHttpClient(t *testing.T, handler func(method, reqUrl string) ([]byte, error)) {
+	t.Helper()
+	old := httpcli.Cli
+	httpcli.Cli = &stubHttpClient{handler: handler}
+	t.Cleanup(func() { httpcli.Cli = old })
+}
+
+// queryParams 解析请求URL中的参数
+func queryParams(t *testing.T, reqUrl string) url.Values {
+	t.Helper()
+	u, err := url.Parse(reqUrl)
+	if err != nil {
+		t.Fatalf("parse url %s error: %v", reqUrl, err)
+	}
+	return u.Query()
+}
+
+func newTestPrvApi() *PrvApi {
+	return New().NewPrvApi(options.WithApiKey("key"), options.WithApiSecretKey("secret"))
+}
+
+func TestFanOut(t *testing.T) {
+	for _, n := range []int{0, 1, batchConcurrency, 3*batchConcurrency + 1} {
+		var (
+			calls   = make([]int32, n)
+			running int32
+			peak    int32
+			mu      sync.Mutex
+		)
+
+		fanOut(n, func(i int) {
+			cur := atomic.AddInt32(&running, 1)
+			mu.Lock()
+			peak = max(peak, cur)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&calls[i], 1)
+			atomic.AddInt32(&running, -1)
+		})
+
+		for i, c := range calls {
+			if c != 1 {
+				t.Errorf("n=%d: fn(%d) called %d times, want 1", n, i, c)
+			}
+		}
+		if peak > batchConcurrency {
+			t.Errorf("n=%d: %d concurrent calls, want at most %d", n, peak, batchConcurrency)
+		}
+	}
+}
+
+func TestCreateOrdersMapsResultsToRequests(t *testing.T) {
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		params := queryParams(t, reqUrl)
+		if method != http.MethodPost || params.Get("quantity") == "" {
+			return nil, fmt.Errorf("unexpected request %s %s", method, reqUrl)
+		}
+		if params.Get("quantity") == "0.666" {
+			return []byte(`{"code":-2010,"msg":"Account has insufficient balance for requested action."}`), errors.New("400 Bad Request")
+		}
+		return []byte(fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":%s,"clientOrderId":"%s"}`,
+			strings.TrimPrefix(params.Get("newClientOrderId"), "c"), params.Get("newClientOrderId"))), nil
+	})
+
+	pair := model.CurrencyPair{Symbol: "BTCUSDT", PricePrecision: 2, QtyPrecision: 5}
+	reqs := make([]model.BatchOrderReq, 25)
+	for i := range reqs {
+		reqs[i] = model.BatchOrderReq{
+			Pair: pair, Qty: 0.01, Price: 30000, Side: model.Spot_Buy, OrderTy: model.OrderType_Limit,
+			Opts: []model.OptionParameter{model.OptionParameter{}.OrderClientID(fmt.Sprintf("c%d", i))},
+		}
+	}
+	reqs[2].Qty, reqs[17].Qty = 0.666, 0.666
+
+	results, err := newTestPrvApi().CreateOrders(reqs)
+	if err != nil {
+		t.Fatalf("CreateOrders() error = %v", err)
+	}
+
+	for i, r := range results {
+		if i == 2 || i == 17 {
+			if r.Err == nil || r.Order != nil {
+				t.Errorf("result %d = %+v, want error", i, r)
+			}
+			continue
+		}
+		if r.Err != nil || r.Order == nil || r.Order.Id != strconv.Itoa(i) || r.Order.CId != fmt.Sprintf("c%d", i) {
+			t.Errorf("result %d = %+v, want order %d", i, r, i)
+		}
+	}
+}
+
+func TestCancelOrdersMapsResultsToIds(t *testing.T) {
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		id, _ := strconv.Atoi(queryParams(t, reqUrl).Get("orderId"))
+		if method != http.MethodDelete || id == 0 {
+			return nil, fmt.Errorf("unexpected request %s %s", method, reqUrl)
+		}
+		if id%3 == 0 {
+			return []byte(`{"code":-2011,"msg":"Unknown order sent."}`), errors.New("400 Bad Request")
+		}
+		return []byte(fmt.Sprintf(`{"symbol":"BTCUSDT","orderId":%d,"status":"CANCELED"}`, id)), nil
+	})
+
+	ids := make([]string, 23)
+	for i := range ids {
+		ids[i] = strconv.Itoa(i + 1)
+	}
+
+	pair := model.CurrencyPair{Symbol: "BTCUSDT"}
+	results, err := newTestPrvApi().CancelOrders(pair, ids)
+	if err != nil {
+		t.Fatalf("CancelOrders() error = %v", err)
+	}
+
+	for i, r := range results {
+		if (i+1)%3 == 0 {
+			if r.Err == nil || r.Order != nil {
+				t.Errorf("result %d = %+v, want error", i, r)
+			}
+			continue
+		}
+		if r.Err != nil || r.Order == nil || r.Order.Id != ids[i] || r.Order.Status != model.OrderStatus_Canceled {
+			t.Errorf("result %d = %+v, want order %s canceled", i, r, ids[i])
+		}
+	}
+}
+
+func TestGetFillsPagesByDay(t *testing.T) {
+	const (
+		day       = int64(24 * 60 * 60 * 1000)
+		startTime = int64(1672531200000) //2023-01-01 00:00 UTC
+	)
+
+	//每天一笔成交，第二天的成交恰好在窗口的起点
+	fillTimes := []int64{startTime + 10, startTime + day, startTime + 2*day + 10}
+
+	var windows [][2]int64
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		params := queryParams(t, reqUrl)
+		start, end := cast.ToInt64(params.Get("startTime")), cast.ToInt64(params.Get("endTime"))
+		windows = append(windows, [2]int64{start, end})
+
+		var items []string
+		for i, tm := range fillTimes {
+			if tm >= start && tm <= end {
+				items = append(items, fmt.Sprintf(`{"id":%d,"orderId":1,"symbol":"BTCUSDT","time":%d}`, i+1, tm))
+			}
+		}
+		return []byte("[" + strings.Join(items, ",") + "]"), nil
+	})
+
+	fills, _, err := newTestPrvApi().GetFills(model.CurrencyPair{Symbol: "BTCUSDT"},
+		model.OptionParameter{Key: "startTime", Value: fmt.Sprint(startTime)},
+		model.OptionParameter{Key: "endTime", Value: fmt.Sprint(startTime + 3*day - 1)})
+	if err != nil {
+		t.Fatalf("GetFills() error = %v", err)
+	}
+
+	wantWindows := [][2]int64{
+		{startTime, startTime + day - 1},
+		{startTime + day, startTime + 2*day - 1},
+		{startTime + 2*day, startTime + 3*day - 1},
+	}
+	if fmt.Sprint(windows) != fmt.Sprint(wantWindows) {
+		t.Errorf("GetFills() windows = %v, want %v", windows, wantWindows)
+	}
+	if len(fills) != len(fillTimes) {
+		t.Fatalf("GetFills() returned %d fills, want %d", len(fills), len(fillTimes))
+	}
+	for i, fill := range fills {
+		if fill.Id != fmt.Sprint(i+1) || fill.Time != fillTimes[i] {
+			t.Errorf("fills[%d] = %s@%d, want %d@%d", i, fill.Id, fill.Time, i+1, fillTimes[i])
+		}
+	}
+}
@@ -3,12 +3,14 @@ package spot
 import (
 	"errors"
 	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	. "github.com/nntaoli-project/goex/v2/httpcli"
 	"github.com/nntaoli-project/goex/v2/logger"
 	. "github.com/nntaoli-project/goex/v2/model"
 	. "github.com/nntaoli-project/goex/v2/util"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // GetName 获取交易所名称
@@ -59,7 +61,7 @@ func (s *Spot) GetDepth(pair CurrencyPair, size int, opts ...OptionParameter) (*
 //   - error: 错误信息
 //
 // 注意:
-//   - 如果在opt中传入"symbols"参数，将会覆盖单个symbol的设置
+//   - 如果在opt中传入"symbols"参数，将会覆盖单个symbol的设置，但只解析单个行情，批量获取请使用GetTickers
 func (s *Spot) GetTicker(pair CurrencyPair, opt ...OptionParameter) (*Ticker, []byte, error) {
 	params := url.Values{}
 	params.Set("symbol", pair.Symbol)
@@ -89,6 +91,70 @@ func (s *Spot) GetTicker(pair CurrencyPair, opt ...OptionParameter) (*Ticker, []
 	return tk, data, err
 }
 
+// tickersBySymbolsLimit 按symbols查询行情的最大交易对数量，超过时symbols参数的权重与全量查询相同，直接查询全量
+const tickersBySymbolsLimit = 100
+
+// GetTickers 批量获取多个交易对的行情数据
+// 参数:
+//   - pairs: 交易对列表，为空时返回所有交易对
+//
+// 返回值:
+//   - map[string]*Ticker: 行情数据映射，键为交易对symbol
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 只发送一次请求，pairs超过100个时请求全量行情后按pairs过滤，避免URL过长
+//   - pairs为空时填充完整的交易对信息，交易对信息未加载时会先自动加载
+//
+// 使用示例:
+//
+//	tickers, _, err := spot.GetTickers(btcUsdt, ethUsdt)
+//	last := tickers[btcUsdt.Symbol].Last
+func (s *Spot) GetTickers(pairs ...CurrencyPair) (map[string]*Ticker, []byte, error) {
+	params := url.Values{}
+	if len(pairs) > 0 && len(pairs) <= tickersBySymbolsLimit {
+		symbols := make([]string, 0, len(pairs))
+		for _, pair := range pairs {
+			symbols = append(symbols, `"`+pair.Symbol+`"`)
+		}
+		params.Set("symbols", "["+strings.Join(symbols, ",")+"]")
+	}
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.TickerUri, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	tickers, err := s.UnmarshalerOpts.TickersUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if len(pairs) == 0 {
+		currencyPairM, err := s.symbolRegistry.Load()
+		if err != nil {
+			return nil, data, fmt.Errorf("load exchange info error: %w", err)
+		}
+		for sym, tk := range tickers {
+			if pair, ok := currencyPairM[sym]; ok {
+				tk.Pair = pair
+			}
+		}
+		return tickers, data, nil
+	}
+
+	result := make(map[string]*Ticker, len(pairs))
+	for _, pair := range pairs {
+		if tk, ok := tickers[pair.Symbol]; ok {
+			tk.Pair = pair
+			result[pair.Symbol] = tk
+		}
+	}
+
+	return result, data, nil
+}
+
 // GetKline 获取K线数据
 // 参数:
 //   - pair: 交易对信息
@@ -120,6 +186,68 @@ func (s *Spot) GetKline(pair CurrencyPair, period KlinePeriod, opts ...OptionPar
 	return klines, respBody, err
 }
 
+// klineRangeLimit 翻页获取K线时每页的条数
+const klineRangeLimit = 1000
+
+// GetKlineRange 获取[start, end]区间内的全部K线，自动翻页并去重
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - start: 开始时间(毫秒)，包含
+//   - end: 结束时间(毫秒)，包含
+//   - opts: 可选参数，如timeZone
+//
+// 返回值:
+//   - []Kline: 按时间升序排列的K线
+//   - []KlineGap: 缺失的K线区间
+//   - error: 错误信息，出错时返回已获取的K线
+//
+// 注意:
+//   - 数据量较大(如多年的分钟线)时请使用StreamKlineRange，避免全部K线驻留内存
+func (s *Spot) GetKlineRange(pair CurrencyPair, period KlinePeriod, start, end int64, opts ...OptionParameter) ([]Kline, []KlineGap, error) {
+	var klines []Kline
+	gaps, err := s.StreamKlineRange(pair, period, start, end, func(page []Kline) error {
+		klines = append(klines, page...)
+		return nil
+	}, opts...)
+	return klines, gaps, err
+}
+
+// StreamKlineRange 按页获取[start, end]区间内的K线，每页去重后回调handler
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - start: 开始时间(毫秒)，包含
+//   - end: 结束时间(毫秒)，包含
+//   - handler: 每页K线的回调，按时间升序，返回错误时停止获取
+//   - opts: 可选参数，如timeZone
+//
+// 返回值:
+//   - []KlineGap: 相邻K线之间缺失的区间，月线不检测
+//   - error: 请求或handler返回的错误
+//
+// 使用示例:
+//
+//	gaps, err := spot.StreamKlineRange(pair, Kline_1min, start, end, func(klines []Kline) error {
+//	  return db.Save(klines)
+//	})
+func (s *Spot) StreamKlineRange(pair CurrencyPair, period KlinePeriod, start, end int64, handler func([]Kline) error, opts ...OptionParameter) ([]KlineGap, error) {
+	interval := common.KlineIntervalMillis(adaptKlinePeriod(period))
+	return common.StreamKlineRange(start, end, interval, klineRangeLimit, func(start, end int64) ([]Kline, error) {
+		pageOpts := append([]OptionParameter{}, opts...)
+		pageOpts = append(pageOpts,
+			OptionParameter{Key: "startTime", Value: fmt.Sprint(start)},
+			OptionParameter{Key: "endTime", Value: fmt.Sprint(end)},
+			OptionParameter{Key: "limit", Value: fmt.Sprint(klineRangeLimit)})
+
+		klines, _, err := s.GetKline(pair, period, pageOpts...)
+		for i := range klines {
+			klines[i].Pair = pair
+		}
+		return klines, err
+	}, handler)
+}
+
 // GetExchangeInfo 获取交易所支持的所有交易对信息
 // 返回值:
 //   - map[string]CurrencyPair: 交易对信息映射，key为交易对的symbol，value为交易对的详细信息
@@ -127,9 +255,14 @@ func (s *Spot) GetKline(pair CurrencyPair, period KlinePeriod, opts ...OptionPar
 //   - error: 错误信息
 //
 // 注意:
-//   - 在使用其他API前，建议先调用此方法获取交易对信息
-//   - 返回的交易对信息包含价格精度、数量精度、最小交易量等重要信息
+//   - 每次调用都会重新下载并更新SymbolRegistry，NewCurrencyPair会在首次使用时自动加载，无需先调用此方法
+//   - 返回的交易对信息包含价格精度、数量精度、最小交易量等重要信息，调用方不要修改返回的map
 func (s *Spot) GetExchangeInfo() (map[string]CurrencyPair, []byte, error) {
+	return s.symbolRegistry.Refresh()
+}
+
+// fetchExchangeInfo 下载并解析交易所信息，作为SymbolRegistry的加载方法
+func (s *Spot) fetchExchangeInfo() (map[string]CurrencyPair, []byte, error) {
 	body, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.GetExchangeInfoUri, &url.Values{}, nil)
 	if err != nil {
 		logger.Errorf("[GetExchangeInfo] http request error, body: %s", string(body))
@@ -142,8 +275,6 @@ func (s *Spot) GetExchangeInfo() (map[string]CurrencyPair, []byte, error) {
 		return nil, body, err
 	}
 
-	s.currencyPairM = m
-
 	return m, body, err
 }
 
@@ -151,22 +282,258 @@ func (s *Spot) GetExchangeInfo() (map[string]CurrencyPair, []byte, error) {
 // 参数:
 //   - baseSym: 基础货币符号，如BTC
 //   - quoteSym: 计价货币符号，如USDT
+//   - opts: 现货不需要额外参数，保留以满足goex.IPubRest接口
 //
 // 返回值:
 //   - CurrencyPair: 交易对信息，包含Symbol、BaseCurrency、QuoteCurrency等
 //   - error: 错误信息，如果交易对不存在则返回错误
 //
 // 注意:
-//   - 使用此方法前必须先调用GetExchangeInfo方法
+//   - 首次调用时自动加载交易所信息，之后超过有效期会在后台刷新
 //   - 返回的CurrencyPair对象包含了交易所对该交易对的所有限制信息
-func (s *Spot) NewCurrencyPair(baseSym, quoteSym string) (CurrencyPair, error) {
-	currencyPair := s.currencyPairM[baseSym+quoteSym]
+func (s *Spot) NewCurrencyPair(baseSym, quoteSym string, opts ...OptionParameter) (CurrencyPair, error) {
+	pairs, err := s.symbolRegistry.Load()
+	if err != nil {
+		return CurrencyPair{}, fmt.Errorf("load exchange info error: %w", err)
+	}
+
+	currencyPair := pairs[baseSym+quoteSym]
 	if currencyPair.Symbol == "" {
 		return currencyPair, errors.New("not found currency pair")
 	}
 	return currencyPair, nil
 }
 
+// GetTrades 获取近期成交
+// 参数:
+//   - pair: 交易对信息
+//   - limit: 返回的成交数量，最大1000，<=0时使用币安默认值500
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []Trade: 成交列表，按时间升序排列
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (s *Spot) GetTrades(pair CurrencyPair, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	if limit > 0 {
+		params.Set("limit", fmt.Sprint(limit))
+	}
+	MergeOptionParams(&params, opts...)
+
+	return s.getTrades(pair, s.UriOpts.TradesUri, &params)
+}
+
+// GetHistoricalTrades 获取历史成交
+// 参数:
+//   - pair: 交易对信息
+//   - fromId: 从该成交ID开始返回，为空时返回最近的成交
+//   - limit: 返回的成交数量，最大1000，<=0时使用币安默认值500
+//   - opts: 可选参数
+//
+// 返回值:
+//   - []Trade: 成交列表，按时间升序排列
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	// 从成交ID 28457开始向后翻页
+//	trades, _, err := spot.GetHistoricalTrades(pair, "28457", 1000)
+func (s *Spot) GetHistoricalTrades(pair CurrencyPair, fromId string, limit int, opts ...OptionParameter) ([]Trade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	if limit > 0 {
+		params.Set("limit", fmt.Sprint(limit))
+	}
+	if fromId != "" {
+		params.Set("fromId", fromId)
+	}
+	MergeOptionParams(&params, opts...)
+
+	return s.getTrades(pair, s.UriOpts.HistoricalTradesUri, &params)
+}
+
+func (s *Spot) getTrades(pair CurrencyPair, uri string, params *url.Values) ([]Trade, []byte, error) {
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+uri, params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := s.UnmarshalerOpts.GetTradesResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+// GetAggTrades 获取归集成交
+// 参数:
+//   - pair: 交易对信息
+//   - opts: 可选参数，如fromId、startTime、endTime、limit(默认500，最大1000)
+//
+// 返回值:
+//   - []AggTrade: 归集成交列表，按时间升序排列
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 同时传入startTime和endTime时，间隔不能超过1小时
+func (s *Spot) GetAggTrades(pair CurrencyPair, opts ...OptionParameter) ([]AggTrade, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.AggTradesUri, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	trades, err := s.UnmarshalerOpts.GetAggTradesResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	for i := range trades {
+		trades[i].Pair = pair
+	}
+
+	return trades, data, nil
+}
+
+// GetAvgPrice 获取当前平均价格
+// 参数:
+//   - pair: 交易对信息
+//
+// 返回值:
+//   - *AvgPrice: 平均价格
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (s *Spot) GetAvgPrice(pair CurrencyPair) (*AvgPrice, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.AvgPriceUri, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	avg, err := s.UnmarshalerOpts.GetAvgPriceResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	avg.Pair = pair
+
+	return avg, data, nil
+}
+
+// GetBookTicker 获取最优挂单(买一卖一价和挂单量)
+// 参数:
+//   - pair: 交易对信息
+//   - opts: 可选参数
+//
+// 返回值:
+//   - *BookTicker: 最优挂单
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+func (s *Spot) GetBookTicker(pair CurrencyPair, opts ...OptionParameter) (*BookTicker, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.BookTickerUri, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	book, err := s.UnmarshalerOpts.GetBookTickerResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	book.Pair = pair
+
+	return book, data, nil
+}
+
+// GetRollingTicker 获取滚动窗口行情
+// 参数:
+//   - pair: 交易对信息
+//   - windowSize: 窗口大小，支持1m-59m、1h-23h、1d-7d，为空时默认1d
+//   - opts: 可选参数
+//
+// 返回值:
+//   - *Ticker: 窗口内的行情数据，不包含买一卖一价
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 与GetTicker(24小时行情)不同，窗口按分钟滚动，Timestamp为窗口结束时间
+func (s *Spot) GetRollingTicker(pair CurrencyPair, windowSize string, opts ...OptionParameter) (*Ticker, []byte, error) {
+	params := url.Values{}
+	params.Set("symbol", pair.Symbol)
+	if windowSize != "" {
+		params.Set("windowSize", windowSize)
+	}
+	MergeOptionParams(&params, opts...)
+
+	data, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.RollingTickerUri, &params, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	tk, err := s.UnmarshalerOpts.TickerUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	tk.Pair = pair
+
+	return tk, data, nil
+}
+
+// GetUIKline 获取UI K线数据，针对图表展示做了优化，格式与GetKline一致
+// 参数:
+//   - pair: 交易对信息
+//   - period: K线周期
+//   - opts: 可选参数，如startTime、endTime、timeZone
+//
+// 返回值:
+//   - []Kline: K线数据数组
+//   - []byte: 原始响应数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认返回1000条数据，可以通过opts参数修改
+func (s *Spot) GetUIKline(pair CurrencyPair, period KlinePeriod, opts ...OptionParameter) ([]Kline, []byte, error) {
+	params := url.Values{}
+	params.Set("limit", "1000")
+	params.Set("symbol", pair.Symbol)
+	params.Set("interval", adaptKlinePeriod(period))
+
+	MergeOptionParams(&params, opts...)
+
+	respBody, err := s.DoNoAuthRequest(http.MethodGet, s.UriOpts.Endpoint+s.UriOpts.UIKlineUri, &params, nil)
+	if err != nil {
+		return nil, respBody, err
+	}
+
+	klines, err := s.UnmarshalerOpts.KlineUnmarshaler(respBody)
+
+	for i := range klines {
+		klines[i].Pair = pair
+	}
+
+	return klines, respBody, err
+}
+
 // DoNoAuthRequest 执行不需要认证的HTTP请求
 // 参数:
 //   - method: HTTP方法，如GET、POST等
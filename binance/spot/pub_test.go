@@ -0,0 +1,27 @@
+package spot
+
+import (
+	"github.com/nntaoli-project/goex/v2/model"
+	"testing"
+)
+
+func TestGetUIKlineSetsPair(t *testing.T) {
+	withStubHttpClient(t, func(method, reqUrl string) ([]byte, error) {
+		return []byte(`[[1672531200000,"16500.1","16600.2","16400.3","16550.4","100.5",1672531259999,"1660000","1000","50","830000","0"],` +
+			`[1672531260000,"16550.4","16560.0","16540.0","16555.0","10.5",1672531319999,"173000","100","5","86000","0"]]`), nil
+	})
+
+	pair := model.CurrencyPair{Symbol: "BTCUSDT", BaseSymbol: "BTC", QuoteSymbol: "USDT"}
+	klines, _, err := New().GetUIKline(pair, model.Kline_1min)
+	if err != nil {
+		t.Fatalf("GetUIKline() error = %v", err)
+	}
+	if len(klines) != 2 {
+		t.Fatalf("GetUIKline() returned %d klines, want 2", len(klines))
+	}
+	for i, kline := range klines {
+		if kline.Pair != pair {
+			t.Errorf("klines[%d].Pair = %+v, want %+v", i, kline.Pair, pair)
+		}
+	}
+}
@@ -1,18 +1,15 @@
 package spot
 
 import (
-	. "github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	. "github.com/nntaoli-project/goex/v2/options"
 )
 
-var (
-	currencyPaircacheMap = make(map[string]*CurrencyPair, 6)
-)
-
 type Spot struct {
 	UnmarshalerOpts UnmarshalerOptions
 	UriOpts         UriOptions
-	currencyPairM   map[string]CurrencyPair
+	MarginUriOpts   UriOptions             //杠杆接口，见NewMarginPrvApi
+	symbolRegistry  *common.SymbolRegistry //交易对信息，REST和WebSocket共用
 }
 
 func New() *Spot {
@@ -23,31 +20,82 @@ func New() *Spot {
 			TickerUri:           "/api/v3/ticker/24hr",
 			DepthUri:            "/api/v3/depth",
 			KlineUri:            "/api/v3/klines",
+			UIKlineUri:          "/api/v3/uiKlines",
+			TradesUri:           "/api/v3/trades",
+			HistoricalTradesUri: "/api/v3/historicalTrades",
+			AggTradesUri:        "/api/v3/aggTrades",
+			AvgPriceUri:         "/api/v3/avgPrice",
+			BookTickerUri:       "/api/v3/ticker/bookTicker",
+			RollingTickerUri:    "/api/v3/ticker",
 			NewOrderUri:         "/api/v3/order",
+			TestOrderUri:        "/api/v3/order/test",
+			AmendOrderUri:       "/api/v3/order/cancelReplace",
 			GetPendingOrdersUri: "/api/v3/openOrders",
 			CancelOrderUri:      "/api/v3/order",
 			GetOrderUri:         "/api/v3/order",
 			GetHistoryOrdersUri: "/api/v3/allOrders",
 			GetExchangeInfoUri:  "/api/v3/exchangeInfo",
 			GetAccountUri:       "/api/v3/account",
+			GetFillsUri:         "/api/v3/myTrades",
+			NewOCOOrderUri:      "/api/v3/orderList/oco",
+			NewOTOOrderUri:      "/api/v3/orderList/oto",
+			NewOTOCOOrderUri:    "/api/v3/orderList/otoco",
+			OrderListUri:        "/api/v3/orderList",
+			OpenOrderListUri:    "/api/v3/openOrderList",
+		},
+		MarginUriOpts: UriOptions{
+			Endpoint:              "https://api.binance.com",
+			NewOrderUri:           "/sapi/v1/margin/order",
+			GetOrderUri:           "/sapi/v1/margin/order",
+			CancelOrderUri:        "/sapi/v1/margin/order",
+			GetPendingOrdersUri:   "/sapi/v1/margin/openOrders",
+			GetHistoryOrdersUri:   "/sapi/v1/margin/allOrders",
+			GetFillsUri:           "/sapi/v1/margin/myTrades",
+			GetAccountUri:         "/sapi/v1/margin/account",
+			GetIsolatedAccountUri: "/sapi/v1/margin/isolated/account",
+			BorrowRepayUri:        "/sapi/v1/margin/borrow-repay",
+			GetMaxBorrowableUri:   "/sapi/v1/margin/maxBorrowable",
+			GetInterestHistoryUri: "/sapi/v1/margin/interestHistory",
 		},
 		UnmarshalerOpts: UnmarshalerOptions{
 			ResponseUnmarshaler:                 unmarshaler.UnmarshalResponse,
 			TickerUnmarshaler:                   unmarshaler.UnmarshalGetTickerResponse,
+			TickersUnmarshaler:                  unmarshaler.UnmarshalGetTickersResponse,
 			DepthUnmarshaler:                    unmarshaler.UnmarshalGetDepthResponse,
 			KlineUnmarshaler:                    unmarshaler.UnmarshalGetKlineResponse,
+			GetTradesResponseUnmarshaler:        unmarshaler.UnmarshalGetTradesResponse,
+			GetAggTradesResponseUnmarshaler:     unmarshaler.UnmarshalGetAggTradesResponse,
+			GetAvgPriceResponseUnmarshaler:      unmarshaler.UnmarshalGetAvgPriceResponse,
+			GetBookTickerResponseUnmarshaler:    unmarshaler.UnmarshalGetBookTickerResponse,
 			CreateOrderResponseUnmarshaler:      unmarshaler.UnmarshalCreateOrderResponse,
+			TestOrderResponseUnmarshaler:        unmarshaler.UnmarshalTestOrderResponse,
+			AmendOrderResponseUnmarshaler:       unmarshaler.UnmarshalAmendOrderResponse,
 			GetPendingOrdersResponseUnmarshaler: unmarshaler.UnmarshalGetPendingOrdersResponse,
 			GetOrderInfoResponseUnmarshaler:     unmarshaler.unmarshalOrderResponse,
 			GetHistoryOrdersResponseUnmarshaler: unmarshaler.UnmarshalGetHistoryOrdersResponse,
 			CancelOrderResponseUnmarshaler:      unmarshaler.UnmarshalCancelOrderResponse,
 			GetExchangeInfoResponseUnmarshaler:  unmarshaler.UnmarshalGetExchangeInfoResponse,
 			GetAccountResponseUnmarshaler:       unmarshaler.UnmarshalGetAccountResponse,
+			GetFillsResponseUnmarshaler:         unmarshaler.UnmarshalGetFillsResponse,
+			OrderListResponseUnmarshaler:        unmarshaler.UnmarshalOrderListResponse,
+			GetOrderListsResponseUnmarshaler:    unmarshaler.UnmarshalGetOrderListsResponse,
+
+			GetMarginAccountResponseUnmarshaler:   unmarshaler.UnmarshalGetMarginAccountResponse,
+			GetIsolatedAccountResponseUnmarshaler: unmarshaler.UnmarshalGetIsolatedAccountResponse,
+			GetMaxBorrowableResponseUnmarshaler:   unmarshaler.UnmarshalGetMaxBorrowableResponse,
+			BorrowRepayResponseUnmarshaler:        unmarshaler.UnmarshalBorrowRepayResponse,
+			GetInterestHistoryResponseUnmarshaler: unmarshaler.UnmarshalGetInterestHistoryResponse,
 		},
 	}
+	s.symbolRegistry = common.NewSymbolRegistry(s.fetchExchangeInfo, common.DefaultSymbolRegistryTTL)
 	return s
 }
 
+// SymbolRegistry 返回交易对信息注册表，可以设置有效期、订阅交易对变化，或传给WebSocket.WithSymbolRegistry共用
+func (s *Spot) SymbolRegistry() *common.SymbolRegistry {
+	return s.symbolRegistry
+}
+
 func (s *Spot) WithUriOption(uriOpts ...UriOption) {
 	for _, opt := range uriOpts {
 		opt(&s.UriOpts)
@@ -61,6 +109,14 @@ func (s *Spot) WithUnmarshalerOptions(opts ...UnmarshalerOption) *Spot {
 	return s
 }
 
+// WithMarginUriOption 设置杠杆接口的URI选项
+func (s *Spot) WithMarginUriOption(uriOpts ...UriOption) *Spot {
+	for _, opt := range uriOpts {
+		opt(&s.MarginUriOpts)
+	}
+	return s
+}
+
 func (s *Spot) NewPrvApi(apiOpts ...ApiOption) *PrvApi {
 	prv := NewPrvApi(apiOpts...)
 	prv.Spot = s
@@ -2,13 +2,15 @@ package spot
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/logger"
 	. "github.com/nntaoli-project/goex/v2/model"
+	. "github.com/nntaoli-project/goex/v2/util"
 	"github.com/spf13/cast"
 	"log"
-	"strings"
 )
 
 type RespUnmarshaler struct {
@@ -27,10 +29,7 @@ func (u *RespUnmarshaler) UnmarshalGetDepthResponse(data []byte) (*Depth, error)
 			logger.Errorf("[UnmarshalGetDepthResponse] err=%s", err.Error())
 			return
 		}
-		dep.Bids = append(dep.Bids, DepthItem{
-			Price:  cast.ToFloat64(item[0]),
-			Amount: cast.ToFloat64(item[1]),
-		})
+		dep.Bids = append(dep.Bids, newDepthItem(item[0], item[1]))
 	}, "bids")
 
 	_, err = jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
@@ -40,15 +39,24 @@ func (u *RespUnmarshaler) UnmarshalGetDepthResponse(data []byte) (*Depth, error)
 			logger.Errorf("[UnmarshalGetDepthResponse] err=%s", err.Error())
 			return
 		}
-		dep.Asks = append(dep.Asks, DepthItem{
-			Price:  cast.ToFloat64(item[0]),
-			Amount: cast.ToFloat64(item[1]),
-		})
+		dep.Asks = append(dep.Asks, newDepthItem(item[0], item[1]))
 	}, "asks")
 
 	return &dep, err
 }
 
+// newDepthItem 使用深度档位的价格和数量字符串创建DepthItem
+func newDepthItem(price, amount string) DepthItem {
+	item := DepthItem{Price: cast.ToFloat64(price), Amount: cast.ToFloat64(amount)}
+	if ExactDecimalEnabled() {
+		exact := new(DepthItemExact)
+		exact.Price, _ = NewDecimal(price)
+		exact.Amount, _ = NewDecimal(amount)
+		item.Exact = exact
+	}
+	return item
+}
+
 func (u *RespUnmarshaler) UnmarshalGetTickerResponse(data []byte) (*Ticker, error) {
 	var tk = &Ticker{}
 
@@ -59,6 +67,8 @@ func (u *RespUnmarshaler) UnmarshalGetTickerResponse(data []byte) (*Ticker, erro
 
 	err := jsonparser.ObjectEach(data, func(key []byte, value []byte, dataType jsonparser.ValueType, offset int) error {
 		switch string(key) {
+		case "symbol":
+			tk.Pair.Symbol = string(value)
 		case "lastPrice":
 			tk.Last = cast.ToFloat64(string(value))
 		case "askPrice":
@@ -83,10 +93,34 @@ func (u *RespUnmarshaler) UnmarshalGetTickerResponse(data []byte) (*Ticker, erro
 		return nil, err
 	}
 
+	if ExactDecimalEnabled() {
+		tk.Exact = &TickerExact{
+			Last: common.GetDecimal(data, "lastPrice"),
+			Buy:  common.GetDecimal(data, "bidPrice"),
+			Sell: common.GetDecimal(data, "askPrice"),
+			High: common.GetDecimal(data, "highPrice"),
+			Low:  common.GetDecimal(data, "lowPrice"),
+			Vol:  common.GetDecimal(data, "volume"),
+		}
+	}
+
 	return tk, nil
 
 }
 
+// UnmarshalGetTickersResponse 解析多个交易对的行情数据，键为symbol
+func (u *RespUnmarshaler) UnmarshalGetTickersResponse(data []byte) (map[string]*Ticker, error) {
+	var tickers = make(map[string]*Ticker, 200)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		tk, err := u.UnmarshalGetTickerResponse(value)
+		if err != nil {
+			return
+		}
+		tickers[tk.Pair.Symbol] = tk
+	})
+	return tickers, err
+}
+
 func (u *RespUnmarshaler) UnmarshalGetKlineResponse(data []byte) ([]Kline, error) {
 	var (
 		err    error
@@ -111,12 +145,19 @@ func (u *RespUnmarshaler) UnmarshalGetKlineResponse(data []byte) ([]Kline, error
 			case 4:
 				k.Close = cast.ToFloat64(string(val))
 			case 5:
-				//ignore
-			case 6:
 				k.Vol = cast.ToFloat64(string(val))
 			}
 			i += 1
 		})
+		if ExactDecimalEnabled() {
+			k.Exact = &KlineExact{
+				Open:  common.GetDecimal(value, "[1]"),
+				High:  common.GetDecimal(value, "[2]"),
+				Low:   common.GetDecimal(value, "[3]"),
+				Close: common.GetDecimal(value, "[4]"),
+				Vol:   common.GetDecimal(value, "[5]"),
+			}
+		}
 		klines = append(klines, k)
 	})
 
@@ -143,6 +184,61 @@ func (u *RespUnmarshaler) UnmarshalCreateOrderResponse(data []byte) (*Order, err
 	if err != nil {
 		return nil, err
 	}
+	if ExactDecimalEnabled() {
+		ord.Exact = &OrderExact{ExecutedQty: common.GetDecimal(data, "executedQty")}
+	}
+	return ord, nil
+}
+
+// UnmarshalTestOrderResponse 解析测试下单的手续费率，未传入computeCommissionRates时响应为{}
+func (u *RespUnmarshaler) UnmarshalTestOrderResponse(data []byte) (*CommissionRates, error) {
+	var rates = new(CommissionRates)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		switch string(key) {
+		case "standardCommissionForOrder":
+			makerStr, _ := jsonparser.GetString(val, "maker")
+			takerStr, _ := jsonparser.GetString(val, "taker")
+			rates.Maker = cast.ToFloat64(makerStr)
+			rates.Taker = cast.ToFloat64(takerStr)
+		case "taxCommissionForOrder":
+			makerStr, _ := jsonparser.GetString(val, "maker")
+			takerStr, _ := jsonparser.GetString(val, "taker")
+			rates.TaxMaker = cast.ToFloat64(makerStr)
+			rates.TaxTaker = cast.ToFloat64(takerStr)
+		case "discount":
+			discountStr, _ := jsonparser.GetString(val, "discount")
+			rates.Discount = cast.ToFloat64(discountStr)
+			rates.DiscountAsset, _ = jsonparser.GetString(val, "discountAsset")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+// UnmarshalAmendOrderResponse 解析撤销并重新下单(cancelReplace)的响应，返回新订单
+func (u *RespUnmarshaler) UnmarshalAmendOrderResponse(data []byte) (*Order, error) {
+	newOrderResult, _ := jsonparser.GetString(data, "newOrderResult")
+	if newOrderResult != "SUCCESS" {
+		return nil, errors.New(string(data))
+	}
+
+	newOrderData, _, _, err := jsonparser.Get(data, "newOrderResponse")
+	if err != nil {
+		return nil, err
+	}
+
+	ord, err := u.unmarshalOrderResponse(newOrderData)
+	if err != nil {
+		return nil, err
+	}
+
+	if ord.CreatedAt == 0 {
+		ord.CreatedAt, _ = jsonparser.GetInt(newOrderData, "transactTime")
+	}
+
 	return ord, nil
 }
 
@@ -200,6 +296,59 @@ func (u *RespUnmarshaler) UnmarshalGetAccountResponse(data []byte) (map[string]A
 	return accounts, err
 }
 
+func (u *RespUnmarshaler) UnmarshalGetFillsResponse(data []byte) ([]Fill, error) {
+	var fills []Fill
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var fill Fill
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				fill.Id = valStr
+			case "orderId":
+				fill.OrderId = valStr
+			case "symbol":
+				fill.Symbol = valStr
+			case "price":
+				fill.Price = cast.ToFloat64(valStr)
+			case "qty":
+				fill.Qty = cast.ToFloat64(valStr)
+			case "quoteQty":
+				fill.QuoteQty = cast.ToFloat64(valStr)
+			case "commission":
+				fill.Commission = cast.ToFloat64(valStr)
+			case "commissionAsset":
+				fill.CommissionAsset = valStr
+			case "isBuyer":
+				if cast.ToBool(valStr) {
+					fill.Side = Spot_Buy
+				} else {
+					fill.Side = Spot_Sell
+				}
+			case "isMaker":
+				fill.IsMaker = cast.ToBool(valStr)
+			case "time":
+				fill.Time = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.Warnf("[UnmarshalGetFillsResponse] err=%s", err.Error())
+			return
+		}
+		if ExactDecimalEnabled() {
+			fill.Exact = &FillExact{
+				Price:      common.GetDecimal(value, "price"),
+				Qty:        common.GetDecimal(value, "qty"),
+				QuoteQty:   common.GetDecimal(value, "quoteQty"),
+				Commission: common.GetDecimal(value, "commission"),
+			}
+		}
+		fills = append(fills, fill)
+	})
+	return fills, err
+}
+
 func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (*Order, error) {
 	ord := new(Order)
 	tm := int64(0)
@@ -229,6 +378,8 @@ func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (*Order, error) {
 			ord.Side = adaptOrderOrigSide(valStr)
 		case "type":
 			ord.OrderTy = adaptOrderOrigType(valStr)
+		case "stopPrice":
+			ord.StopPrice = cast.ToFloat64(valStr)
 		}
 		return nil
 	})
@@ -243,6 +394,18 @@ func (u *RespUnmarshaler) unmarshalOrderResponse(data []byte) (*Order, error) {
 		ord.FinishedAt = tm
 	}
 
+	if ExactDecimalEnabled() {
+		ord.Exact = &OrderExact{
+			Price:       common.GetDecimal(data, "price"),
+			Qty:         common.GetDecimal(data, "origQty"),
+			ExecutedQty: common.GetDecimal(data, "executedQty"),
+			StopPrice:   common.GetDecimal(data, "stopPrice"),
+		}
+		if quoteQty := common.GetDecimal(data, "cummulativeQuoteQty"); quoteQty.Sign() > 0 && ord.Exact.ExecutedQty.Sign() > 0 {
+			ord.Exact.PriceAvg = quoteQty.Div(ord.Exact.ExecutedQty, 16)
+		}
+	}
+
 	return ord, err
 }
 
@@ -272,43 +435,11 @@ func (u *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[str
 				currencyPair.QuoteSymbol = valStr
 
 			case "filters":
-				_, err = jsonparser.ArrayEach(val, func(filterData []byte, dataType jsonparser.ValueType, offset int, err error) {
-					filterType, _ := jsonparser.GetString(filterData, "filterType")
-					if filterType == "LOT_SIZE" {
-						var (
-							minQty []byte
-							maxQty []byte
-						)
-
-						minQty, _, _, err = jsonparser.Get(filterData, "minQty")
-						maxQty, _, _, err = jsonparser.Get(filterData, "maxQty")
-						currencyPair.MinQty = cast.ToFloat64(string(minQty))
-						currencyPair.MaxQty = cast.ToFloat64(string(maxQty))
-
-						stepSize, err := jsonparser.GetString(filterData, "stepSize")
-						if err == nil {
-							idx := strings.Index(stepSize, "1")
-							currencyPair.QtyPrecision = idx - 1
-						}
-					}
-
-					if filterType == "MARKET_LOT_SIZE" {
-
-					}
-
-					if filterType == "PRICE_FILTER" {
-						tickSize, _ := jsonparser.GetString(filterData, "tickSize")
-						idx := strings.Index(tickSize, "1")
-						currencyPair.PricePrecision = idx - 1
-					}
-
-					//if filterType == "MIN_NOTIONAL" {
-					//	currencyPair.MinQty, err = jsonparser.GetFloat(filterData, "notional")
-					//	if err != nil {
-					//		logger.Errorf("[UnmarshalGetExchangeInfoResponse] get notional error: %s", err.Error())
-					//	}
-					//}
-				})
+				currencyPair.Filters = common.UnmarshalSymbolFilters(val)
+				currencyPair.MinQty = currencyPair.Filters.MinQty
+				currencyPair.MaxQty = currencyPair.Filters.MaxQty
+				currencyPair.PricePrecision = StepPrecision(currencyPair.Filters.TickSize)
+				currencyPair.QtyPrecision = StepPrecision(currencyPair.Filters.StepSize)
 			}
 			return err
 		})
@@ -323,3 +454,361 @@ func (u *RespUnmarshaler) UnmarshalGetExchangeInfoResponse(data []byte) (map[str
 func (u *RespUnmarshaler) UnmarshalResponse(data []byte, res interface{}) error {
 	return json.Unmarshal(data, res)
 }
+
+func (u *RespUnmarshaler) unmarshalMarginAsset(data []byte) (MarginAsset, error) {
+	var asset MarginAsset
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "asset":
+			asset.Coin = valStr
+		case "free":
+			asset.Free = cast.ToFloat64(valStr)
+		case "locked":
+			asset.Locked = cast.ToFloat64(valStr)
+		case "borrowed":
+			asset.Borrowed = cast.ToFloat64(valStr)
+		case "interest":
+			asset.Interest = cast.ToFloat64(valStr)
+		case "netAsset":
+			asset.NetAsset = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+	return asset, err
+}
+
+// UnmarshalGetMarginAccountResponse 解析全仓杠杆账户(/sapi/v1/margin/account)
+func (u *RespUnmarshaler) UnmarshalGetMarginAccountResponse(data []byte) (*MarginAccount, error) {
+	var acc = MarginAccount{Assets: make(map[string]MarginAsset, 6)}
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "marginLevel":
+			acc.MarginLevel = cast.ToFloat64(valStr)
+		case "totalAssetOfBtc":
+			acc.TotalAssetOfBtc = cast.ToFloat64(valStr)
+		case "totalLiabilityOfBtc":
+			acc.TotalLiabilityOfBtc = cast.ToFloat64(valStr)
+		case "totalNetAssetOfBtc":
+			acc.TotalNetAssetOfBtc = cast.ToFloat64(valStr)
+		case "tradeEnabled":
+			acc.TradeEnabled = cast.ToBool(valStr)
+		case "borrowEnabled":
+			acc.BorrowEnabled = cast.ToBool(valStr)
+		case "userAssets":
+			_, err := jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+				asset, err := u.unmarshalMarginAsset(value)
+				if err != nil {
+					logger.Warnf("[UnmarshalGetMarginAccountResponse] err=%s", err.Error())
+					return
+				}
+				acc.Assets[asset.Coin] = asset
+			})
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// UnmarshalGetIsolatedAccountResponse 解析逐仓杠杆账户(/sapi/v1/margin/isolated/account)，键为交易对symbol
+func (u *RespUnmarshaler) UnmarshalGetIsolatedAccountResponse(data []byte) (map[string]IsolatedMarginAccount, error) {
+	var accounts = make(map[string]IsolatedMarginAccount, 4)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var acc IsolatedMarginAccount
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "symbol":
+				acc.Symbol = valStr
+			case "baseAsset":
+				acc.BaseAsset, _ = u.unmarshalMarginAsset(val)
+			case "quoteAsset":
+				acc.QuoteAsset, _ = u.unmarshalMarginAsset(val)
+			case "marginLevel":
+				acc.MarginLevel = cast.ToFloat64(valStr)
+			case "marginRatio":
+				acc.MarginRatio = cast.ToFloat64(valStr)
+			case "liquidatePrice":
+				acc.LiquidatePrice = cast.ToFloat64(valStr)
+			case "indexPrice":
+				acc.IndexPrice = cast.ToFloat64(valStr)
+			case "enabled":
+				acc.Enabled = cast.ToBool(valStr)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.Warnf("[UnmarshalGetIsolatedAccountResponse] err=%s", err.Error())
+			return
+		}
+		accounts[acc.Symbol] = acc
+	}, "assets")
+	return accounts, err
+}
+
+// UnmarshalGetMaxBorrowableResponse 解析最大可借数量
+func (u *RespUnmarshaler) UnmarshalGetMaxBorrowableResponse(data []byte) (float64, error) {
+	amount, err := jsonparser.GetString(data, "amount")
+	if err != nil {
+		return 0, errors.New(string(data))
+	}
+	return cast.ToFloat64(amount), nil
+}
+
+// UnmarshalBorrowRepayResponse 解析借款/还款响应，返回交易ID
+func (u *RespUnmarshaler) UnmarshalBorrowRepayResponse(data []byte) (string, error) {
+	tranId, _, _, err := jsonparser.Get(data, "tranId")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return string(tranId), nil
+}
+
+// UnmarshalGetInterestHistoryResponse 解析杠杆利息历史(rows数组)
+func (u *RespUnmarshaler) UnmarshalGetInterestHistoryResponse(data []byte) ([]MarginInterest, error) {
+	var interests []MarginInterest
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var interest MarginInterest
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "txId":
+				interest.TxId = valStr
+			case "asset":
+				interest.Coin = valStr
+			case "principal":
+				interest.Principal = cast.ToFloat64(valStr)
+			case "interest":
+				interest.Interest = cast.ToFloat64(valStr)
+			case "interestRate":
+				interest.InterestRate = cast.ToFloat64(valStr)
+			case "type":
+				interest.Type = valStr
+			case "isolatedSymbol":
+				interest.IsolatedSymbol = valStr
+			case "interestAccuredTime":
+				interest.Time = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		if err != nil {
+			logger.Warnf("[UnmarshalGetInterestHistoryResponse] err=%s", err.Error())
+			return
+		}
+		interests = append(interests, interest)
+	}, "rows")
+	return interests, err
+}
+
+// UnmarshalOrderListResponse 解析OCO/OTO/OTOCO下单、撤单和查询的响应，orderReports存在时使用完整的订单信息
+func (u *RespUnmarshaler) UnmarshalOrderListResponse(data []byte) (*OrderList, error) {
+	var (
+		list    = new(OrderList)
+		reports []Order
+	)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "symbol":
+			list.Pair.Symbol = valStr
+		case "orderListId":
+			list.Id = valStr
+		case "listClientOrderId":
+			list.CId = valStr
+		case "contingencyType":
+			list.ContingencyType = valStr
+		case "listStatusType":
+			list.StatusType = valStr
+		case "listOrderStatus":
+			list.Status = OrderListStatus(valStr)
+		case "transactionTime":
+			list.CreatedAt = cast.ToInt64(valStr)
+		case "orders":
+			_, err := jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+				var ord Order
+				orderId, _, _, _ := jsonparser.Get(value, "orderId")
+				ord.Id = string(orderId)
+				ord.CId, _ = jsonparser.GetString(value, "clientOrderId")
+				list.Orders = append(list.Orders, ord)
+			})
+			return err
+		case "orderReports":
+			_, err := jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+				ord, err := u.unmarshalOrderResponse(value)
+				if err != nil {
+					logger.Warnf("[UnmarshalOrderListResponse] err=%s", err.Error())
+					return
+				}
+				if ord.CreatedAt == 0 {
+					ord.CreatedAt, _ = jsonparser.GetInt(value, "transactTime")
+				}
+				reports = append(reports, *ord)
+			})
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reports) > 0 {
+		list.Orders = reports
+	}
+
+	return list, nil
+}
+
+func (u *RespUnmarshaler) UnmarshalGetOrderListsResponse(data []byte) ([]OrderList, error) {
+	var lists []OrderList
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		list, err := u.UnmarshalOrderListResponse(value)
+		if err != nil {
+			logger.Warnf("[UnmarshalGetOrderListsResponse] err=%s", err.Error())
+			return
+		}
+		lists = append(lists, *list)
+	})
+	return lists, err
+}
+
+// UnmarshalListStatusEvent 解析用户数据流中的listStatus推送，返回的订单只有Id和CId
+func (u *RespUnmarshaler) UnmarshalListStatusEvent(data []byte) (*OrderList, error) {
+	var list = new(OrderList)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "s":
+			list.Pair.Symbol = valStr
+		case "g":
+			list.Id = valStr
+		case "C":
+			list.CId = valStr
+		case "c":
+			list.ContingencyType = valStr
+		case "l":
+			list.StatusType = valStr
+		case "L":
+			list.Status = OrderListStatus(valStr)
+		case "r":
+			if valStr != "NONE" {
+				list.RejectReason = valStr
+			}
+		case "T":
+			list.CreatedAt = cast.ToInt64(valStr)
+		case "O":
+			_, err := jsonparser.ArrayEach(val, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+				var ord Order
+				orderId, _, _, _ := jsonparser.Get(value, "i")
+				ord.Id = string(orderId)
+				ord.CId, _ = jsonparser.GetString(value, "c")
+				list.Orders = append(list.Orders, ord)
+			})
+			return err
+		}
+		return nil
+	})
+	return list, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetTradesResponse(data []byte) ([]Trade, error) {
+	var trades []Trade
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade Trade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				trade.Tid = valStr
+			case "price":
+				trade.Price = cast.ToFloat64(valStr)
+			case "qty":
+				trade.Amount = cast.ToFloat64(valStr)
+			case "time":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "isBuyerMaker":
+				//买方为maker表示主动卖出
+				if cast.ToBool(valStr) {
+					trade.Direction = "sell"
+				} else {
+					trade.Direction = "buy"
+				}
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+	return trades, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetAggTradesResponse(data []byte) ([]AggTrade, error) {
+	var trades []AggTrade
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var trade AggTrade
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "a":
+				trade.AggId = valStr
+			case "p":
+				trade.Price = cast.ToFloat64(valStr)
+			case "q":
+				trade.Qty = cast.ToFloat64(valStr)
+			case "f":
+				trade.FirstTradeId = valStr
+			case "l":
+				trade.LastTradeId = valStr
+			case "T":
+				trade.Timestamp = cast.ToInt64(valStr)
+			case "m":
+				trade.IsBuyerMaker = cast.ToBool(valStr)
+			}
+			return nil
+		})
+		trades = append(trades, trade)
+	})
+	return trades, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetAvgPriceResponse(data []byte) (*AvgPrice, error) {
+	var avg = new(AvgPrice)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "mins":
+			avg.Mins = cast.ToInt(valStr)
+		case "price":
+			avg.Price = cast.ToFloat64(valStr)
+		case "closeTime":
+			avg.CloseTime = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	return avg, err
+}
+
+func (u *RespUnmarshaler) UnmarshalGetBookTickerResponse(data []byte) (*BookTicker, error) {
+	var book = new(BookTicker)
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "symbol":
+			book.Pair.Symbol = valStr
+		case "bidPrice":
+			book.BidPrice = cast.ToFloat64(valStr)
+		case "bidQty":
+			book.BidQty = cast.ToFloat64(valStr)
+		case "askPrice":
+			book.AskPrice = cast.ToFloat64(valStr)
+		case "askQty":
+			book.AskQty = cast.ToFloat64(valStr)
+		}
+		return nil
+	})
+	return book, err
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
 	"github.com/nntaoli-project/goex/v2/logger"
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/nntaoli-project/goex/v2/util"
@@ -27,7 +28,14 @@ type WebSocket struct {
 	errorHandler        func(error)
 	connectedHandler    func()
 	disconnectedHandler func(error)
-	currencyPairM       map[string]model.CurrencyPair
+	symbolRegistry      *common.SymbolRegistry
+	orderListHandler    func(*model.OrderList)
+	apiKey              string
+	apiSecret           string
+	listenKeyURL        string
+	listenKey           string
+	listenKeyExpireTime time.Time
+	keepAliveStarted    bool
 }
 
 // NewWebSocket 创建币安现货WebSocket API
@@ -39,7 +47,8 @@ func NewWebSocket() *WebSocket {
 		tickerHandlers: make(map[string]func(*model.Ticker)),
 		klineHandlers:  make(map[string]func([]model.Kline)),
 		tradeHandlers:  make(map[string]func([]model.Trade)),
-		currencyPairM:  make(map[string]model.CurrencyPair),
+		symbolRegistry: New().SymbolRegistry(),
+		listenKeyURL:   "https://api.binance.com/api/v3/userDataStream",
 	}
 
 	// 设置WebSocket客户端
@@ -83,6 +92,24 @@ func NewWebSocket() *WebSocket {
 	return ws
 }
 
+// WithSymbolRegistry 使用REST接口的交易对信息注册表，如spot.SymbolRegistry()，避免重复下载交易所信息
+// 注意:
+//   - 未设置时使用独立的注册表，在Connect时加载
+func (ws *WebSocket) WithSymbolRegistry(registry *common.SymbolRegistry) *WebSocket {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.symbolRegistry = registry
+	return ws
+}
+
+// registry 返回交易对信息注册表，WithSymbolRegistry可能在推送处理期间被调用，需要加锁读取
+func (ws *WebSocket) registry() *common.SymbolRegistry {
+	ws.mutex.RLock()
+	defer ws.mutex.RUnlock()
+	return ws.symbolRegistry
+}
+
 // GetName 获取交易所名称
 func (ws *WebSocket) GetName() string {
 	return ws.name
@@ -97,13 +124,10 @@ func (ws *WebSocket) Connect() error {
 		return errors.New("already connected")
 	}
 
-	// 获取交易对信息
-	spot := New()
-	currencyPairM, _, err := spot.GetExchangeInfo()
-	if err != nil {
+	// 获取交易对信息，注册表已加载时不会重复下载
+	if _, err := ws.symbolRegistry.Load(); err != nil {
 		return fmt.Errorf("failed to get exchange info: %w", err)
 	}
-	ws.currencyPairM = currencyPairM
 
 	// 连接到WebSocket服务器
 	return ws.ws.Connect(ws.baseURL)
@@ -482,6 +506,8 @@ func (ws *WebSocket) handleMessage(message []byte) {
 			ws.handleKlineUpdateMessage(msg)
 		case "trade":
 			ws.handleTradeUpdateMessage(msg)
+		case "listStatus":
+			ws.handleListStatusMessage(message)
 		default:
 			logger.Debugf("[Binance] Unhandled event type: %s", eventType)
 		}
@@ -519,9 +545,7 @@ func (ws *WebSocket) handleDepthMessage(symbol string, data interface{}) {
 	}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[strings.ToUpper(symbol)]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(strings.ToUpper(symbol))
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", symbol)
 		return
@@ -582,9 +606,7 @@ func (ws *WebSocket) handleDepthUpdateMessage(msg map[string]interface{}) {
 	}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", symbol)
 		return
@@ -677,9 +699,7 @@ func (ws *WebSocket) handleTickerMessage(symbol string, data interface{}) {
 	ticker := &model.Ticker{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[tickerData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(tickerData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", tickerData.Symbol)
 		return
@@ -715,9 +735,7 @@ func (ws *WebSocket) handleTickerUpdateMessage(msg map[string]interface{}) {
 	ticker := &model.Ticker{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", symbol)
 		return
@@ -797,9 +815,7 @@ func (ws *WebSocket) handleKlineMessage(symbol string, streamType string, data i
 	kline := model.Kline{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[klineData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(klineData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", klineData.Symbol)
 		return
@@ -844,9 +860,7 @@ func (ws *WebSocket) handleKlineUpdateMessage(msg map[string]interface{}) {
 	kline := model.Kline{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", symbol)
 		return
@@ -904,9 +918,7 @@ func (ws *WebSocket) handleTradeMessage(symbol string, data interface{}) {
 	trade := model.Trade{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[tradeData.Symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(tradeData.Symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", tradeData.Symbol)
 		return
@@ -950,9 +962,7 @@ func (ws *WebSocket) handleTradeUpdateMessage(msg map[string]interface{}) {
 	trade := model.Trade{}
 
 	// 设置交易对
-	ws.mutex.RLock()
-	pair, ok := ws.currencyPairM[symbol]
-	ws.mutex.RUnlock()
+	pair, ok := ws.registry().BySymbol(symbol)
 	if !ok {
 		logger.Errorf("[Binance] Unknown symbol: %s", symbol)
 		return
@@ -0,0 +1,198 @@
+package spot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/httpcli"
+	"github.com/nntaoli-project/goex/v2/logger"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"time"
+)
+
+// WithApiKey 设置API密钥，订阅用户数据流(如SubscribeOrderList)时需要
+func (ws *WebSocket) WithApiKey(apiKey, apiSecret string) *WebSocket {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	ws.apiKey = apiKey
+	ws.apiSecret = apiSecret
+	return ws
+}
+
+// SubscribeOrderList 订阅订单列表(OCO/OTO/OTOCO)状态更新，对应用户数据流的listStatus推送
+func (ws *WebSocket) SubscribeOrderList(handler func(*model.OrderList), opts ...model.OptionParameter) error {
+	if !ws.IsConnected() {
+		return errors.New("not connected")
+	}
+
+	ws.mutex.RLock()
+	apiKey := ws.apiKey
+	ws.mutex.RUnlock()
+
+	if apiKey == "" {
+		return errors.New("API key is required for user data stream")
+	}
+
+	// 获取listenKey
+	err := ws.getListenKey()
+	if err != nil {
+		return err
+	}
+
+	// 设置处理器，续期协程只启动一个
+	ws.mutex.Lock()
+	ws.orderListHandler = handler
+	listenKey := ws.listenKey
+	startKeepAlive := !ws.keepAliveStarted
+	ws.keepAliveStarted = true
+	ws.mutex.Unlock()
+
+	// 构造订阅消息
+	subMsg := map[string]interface{}{
+		"method": "SUBSCRIBE",
+		"params": []string{
+			listenKey,
+		},
+		"id": util.GenerateOrderClientId(32),
+	}
+
+	// 发送订阅消息
+	msgBytes, err := json.Marshal(subMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal subscription message: %w", err)
+	}
+
+	// 启动listenKey续期协程
+	if startKeepAlive {
+		go ws.keepAliveListenKey()
+	}
+
+	return ws.ws.SendMessage(msgBytes)
+}
+
+// UnsubscribeOrderList 取消订阅订单列表状态更新
+func (ws *WebSocket) UnsubscribeOrderList(opts ...model.OptionParameter) error {
+	if !ws.IsConnected() {
+		return errors.New("not connected")
+	}
+
+	// 移除处理器
+	ws.mutex.Lock()
+	ws.orderListHandler = nil
+	listenKey := ws.listenKey
+	ws.mutex.Unlock()
+
+	if listenKey == "" {
+		return nil
+	}
+
+	// 构造取消订阅消息
+	unsubMsg := map[string]interface{}{
+		"method": "UNSUBSCRIBE",
+		"params": []string{
+			listenKey,
+		},
+		"id": util.GenerateOrderClientId(32),
+	}
+
+	// 发送取消订阅消息
+	msgBytes, err := json.Marshal(unsubMsg)
+	if err != nil {
+		return fmt.Errorf("failed to marshal unsubscription message: %w", err)
+	}
+
+	return ws.ws.SendMessage(msgBytes)
+}
+
+// handleListStatusMessage 处理用户数据流的listStatus推送
+func (ws *WebSocket) handleListStatusMessage(message []byte) {
+	list, err := new(RespUnmarshaler).UnmarshalListStatusEvent(message)
+	if err != nil {
+		logger.Errorf("[Binance] Failed to unmarshal list status: %v", err)
+		return
+	}
+
+	pair, ok := ws.registry().BySymbol(list.Pair.Symbol)
+	ws.mutex.RLock()
+	handler := ws.orderListHandler
+	ws.mutex.RUnlock()
+
+	if ok {
+		fillOrderListPair(list, pair)
+	} else {
+		logger.Warnf("[Binance] Unknown symbol: %s", list.Pair.Symbol)
+	}
+
+	if handler != nil {
+		handler(list)
+	}
+}
+
+// getListenKey 获取用户数据流的listenKey
+func (ws *WebSocket) getListenKey() error {
+	ws.mutex.Lock()
+	defer ws.mutex.Unlock()
+
+	// 如果listenKey已存在且未过期，则直接返回
+	if ws.listenKey != "" && time.Now().Before(ws.listenKeyExpireTime) {
+		return nil
+	}
+
+	// listenKey接口只需要API Key，不需要签名
+	resp, err := httpcli.Cli.DoRequest(http.MethodPost, ws.listenKeyURL, "", map[string]string{
+		"X-MBX-APIKEY": ws.apiKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get listenKey: %w", err)
+	}
+
+	// 解析响应
+	var result struct {
+		ListenKey string `json:"listenKey"`
+	}
+
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("failed to unmarshal listenKey response: %w", err)
+	}
+
+	ws.listenKey = result.ListenKey
+	ws.listenKeyExpireTime = time.Now().Add(60 * time.Minute)
+
+	return nil
+}
+
+// keepAliveListenKey 保持listenKey活跃，listenKey有效期为60分钟
+// 断开连接或取消订阅后退出，下一次SubscribeOrderList时重新启动
+func (ws *WebSocket) keepAliveListenKey() {
+	ticker := time.NewTicker(30 * time.Minute)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ws.mutex.Lock()
+		if !ws.connected || ws.orderListHandler == nil {
+			ws.keepAliveStarted = false
+			ws.mutex.Unlock()
+			return
+		}
+		reqUrl := ws.listenKeyURL + "?listenKey=" + ws.listenKey
+		apiKey := ws.apiKey
+		ws.mutex.Unlock()
+
+		// 续期listenKey
+		_, err := httpcli.Cli.DoRequest(http.MethodPut, reqUrl, "", map[string]string{
+			"X-MBX-APIKEY": apiKey,
+		})
+		if err != nil {
+			logger.Errorf("[Binance] Failed to keep alive listenKey: %v", err)
+			continue
+		}
+
+		ws.mutex.Lock()
+		ws.listenKeyExpireTime = time.Now().Add(60 * time.Minute)
+		ws.mutex.Unlock()
+		logger.Debug("[Binance] ListenKey renewed successfully")
+	}
+}
@@ -0,0 +1,388 @@
+package subaccount
+
+import (
+	"errors"
+	"fmt"
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SubAccount 币安母子账户管理API实现
+// 所有接口都需要使用母账户的API密钥调用
+type SubAccount struct {
+	*common.AuthClient
+
+	UnmarshalOpts options.UnmarshalerOptions
+}
+
+// New 创建币安母子账户管理API实例
+// 参数:
+//   - apiOpts: 母账户的API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *SubAccount: 母子账户管理API实例
+//
+// 使用示例:
+//
+//	sub := subaccount.New(
+//	  options.WithApiKey("master-api-key"),
+//	  options.WithApiSecretKey("master-secret-key"))
+func New(apiOpts ...options.ApiOption) *SubAccount {
+	s := &SubAccount{
+		AuthClient: &common.AuthClient{
+			UriOpts: options.UriOptions{
+				Endpoint:                "https://api.binance.com",
+				GetSubAccountsUri:       "/sapi/v1/sub-account/list",
+				GetSubAssetsUri:         "/sapi/v3/sub-account/assets",
+				GetSubFuturesAccountUri: "/sapi/v2/sub-account/futures/account",
+				SubTransferUri:          "/sapi/v1/sub-account/universalTransfer",
+				EnableSubFuturesUri:     "/sapi/v1/sub-account/futures/enable",
+				SubApiRestrictionUri:    "/sapi/v1/sub-account/subAccountApi/ipRestriction",
+				SetSubApiRestrictionUri: "/sapi/v2/sub-account/subAccountApi/ipRestriction",
+				DeleteSubApiIpUri:       "/sapi/v1/sub-account/subAccountApi/ipRestriction/ipList",
+			},
+		},
+		UnmarshalOpts: options.UnmarshalerOptions{
+			GetSubAccountsResponseUnmarshaler:        UnmarshalGetSubAccountsResponse,
+			GetSubAssetsResponseUnmarshaler:          UnmarshalGetSubAssetsResponse,
+			GetSubFuturesAccountResponseUnmarshaler:  UnmarshalGetSubFuturesAccountResponse,
+			SubTransferResponseUnmarshaler:           UnmarshalSubTransferResponse,
+			GetSubTransferHistoryResponseUnmarshaler: UnmarshalGetSubTransferHistoryResponse,
+			EnableSubFuturesResponseUnmarshaler:      UnmarshalEnableSubFuturesResponse,
+			SubApiRestrictionResponseUnmarshaler:     UnmarshalSubApiRestrictionResponse,
+		},
+	}
+
+	for _, opt := range apiOpts {
+		opt(&s.AuthClient.ApiOpts)
+	}
+
+	return s
+}
+
+// WithUriOption 设置URI选项
+// 参数:
+//   - opts: URI选项函数
+//
+// 返回值:
+//   - *SubAccount: 当前API实例，用于链式调用
+func (s *SubAccount) WithUriOption(opts ...options.UriOption) *SubAccount {
+	for _, opt := range opts {
+		opt(&s.AuthClient.UriOpts)
+	}
+	return s
+}
+
+// WithUnmarshalOption 设置反序列化选项
+// 参数:
+//   - opts: 反序列化选项函数
+//
+// 返回值:
+//   - *SubAccount: 当前API实例，用于链式调用
+func (s *SubAccount) WithUnmarshalOption(opts ...options.UnmarshalerOption) *SubAccount {
+	for _, opt := range opts {
+		opt(&s.UnmarshalOpts)
+	}
+	return s
+}
+
+// GetSubAccounts 查询子账户列表
+// 参数:
+//   - opts: 可选参数，如email、isFreeze、page、limit
+//
+// 返回值:
+//   - []model.SubAccount: 子账户列表
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认每页返回200条，通过page参数翻页
+func (s *SubAccount) GetSubAccounts(opts ...model.OptionParameter) ([]model.SubAccount, []byte, error) {
+	param := &url.Values{}
+	param.Set("limit", "200")
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.GetSubAccountsUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	accounts, err := s.UnmarshalOpts.GetSubAccountsResponseUnmarshaler(data)
+	return accounts, data, err
+}
+
+// GetSpotBalance 查询子账户现货资产
+// 参数:
+//   - email: 子账户邮箱
+//
+// 返回值:
+//   - map[string]model.Account: 现货资产，键为币种
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (s *SubAccount) GetSpotBalance(email string) (map[string]model.Account, []byte, error) {
+	param := &url.Values{}
+	param.Set("email", email)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.GetSubAssetsUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	accounts, err := s.UnmarshalOpts.GetSubAssetsResponseUnmarshaler(data)
+	return accounts, data, err
+}
+
+// GetFuturesBalance 查询子账户合约资产
+// 参数:
+//   - email: 子账户邮箱
+//   - futuresType: model.SubAccountFutures_UM(U本位) / model.SubAccountFutures_CM(币本位)
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 合约资产，键为币种
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 子账户需要先开通合约，见EnableFutures
+func (s *SubAccount) GetFuturesBalance(email string, futuresType model.SubAccountFuturesType) (map[string]model.FuturesAccount, []byte, error) {
+	param := &url.Values{}
+	param.Set("email", email)
+	param.Set("futuresType", fmt.Sprint(int(futuresType)))
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.GetSubFuturesAccountUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	accounts, err := s.UnmarshalOpts.GetSubFuturesAccountResponseUnmarshaler(data)
+	return accounts, data, err
+}
+
+// GetBalance 查询子账户资产汇总
+// 参数:
+//   - email: 子账户邮箱
+//   - futuresTypes: 需要同时查询的合约类型，不传时只查询现货资产
+//
+// 返回值:
+//   - *model.SubAccountBalance: 资产汇总
+//   - error: 错误信息，任一账户查询失败都会返回错误
+//
+// 使用示例:
+//
+//	subs, _, _ := sub.GetSubAccounts()
+//	for _, acc := range subs {
+//	  bal, err := sub.GetBalance(acc.Email, model.SubAccountFutures_UM)
+//	  ...
+//	}
+func (s *SubAccount) GetBalance(email string, futuresTypes ...model.SubAccountFuturesType) (*model.SubAccountBalance, error) {
+	var (
+		bal = &model.SubAccountBalance{Email: email}
+		err error
+	)
+
+	bal.Spot, _, err = s.GetSpotBalance(email)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ty := range futuresTypes {
+		accounts, _, err := s.GetFuturesBalance(email, ty)
+		if err != nil {
+			return nil, err
+		}
+		switch ty {
+		case model.SubAccountFutures_UM:
+			bal.UMFutures = accounts
+		case model.SubAccountFutures_CM:
+			bal.CMFutures = accounts
+		}
+	}
+
+	return bal, nil
+}
+
+// Transfer 母子账户万向划转
+// 参数:
+//   - fromEmail: 转出子账户邮箱，为空表示母账户
+//   - toEmail: 转入子账户邮箱，为空表示母账户
+//   - from: 转出账户类型，如model.SubAccount_Spot
+//   - to: 转入账户类型，如model.SubAccount_USDTFuture
+//   - coin: 币种
+//   - amount: 划转数量
+//   - opts: 可选参数，如OptionParameter{}.OrderClientID(自定义划转ID)、逐仓杠杆的symbol
+//
+// 返回值:
+//   - string: 划转ID
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - fromEmail和toEmail不能同时为空
+func (s *SubAccount) Transfer(fromEmail, toEmail string, from, to model.SubAccountType, coin string, amount float64, opts ...model.OptionParameter) (string, []byte, error) {
+	if fromEmail == "" && toEmail == "" {
+		return "", nil, errors.New("fromEmail and toEmail can not both be empty")
+	}
+
+	param := &url.Values{}
+	if fromEmail != "" {
+		param.Set("fromEmail", fromEmail)
+	}
+	if toEmail != "" {
+		param.Set("toEmail", toEmail)
+	}
+	param.Set("fromAccountType", string(from))
+	param.Set("toAccountType", string(to))
+	param.Set("asset", coin)
+	param.Set("amount", util.FloatToString(amount, 8))
+
+	util.MergeOptionParams(param, opts...)
+	if cid := param.Get(model.Order_Client_ID__Opt_Key); cid != "" {
+		param.Set("clientTranId", cid)
+		param.Del(model.Order_Client_ID__Opt_Key)
+	}
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.SubTransferUri, param, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	tranId, err := s.UnmarshalOpts.SubTransferResponseUnmarshaler(data)
+	return tranId, data, err
+}
+
+// GetTransferHistory 查询母子账户万向划转记录
+// 参数:
+//   - opts: 可选参数，如fromEmail、toEmail、clientTranId、startTime、endTime、page、limit
+//
+// 返回值:
+//   - []model.SubAccountTransfer: 划转记录
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 不传时间时默认返回最近30天的记录
+func (s *SubAccount) GetTransferHistory(opts ...model.OptionParameter) ([]model.SubAccountTransfer, []byte, error) {
+	param := &url.Values{}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.SubTransferUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	transfers, err := s.UnmarshalOpts.GetSubTransferHistoryResponseUnmarshaler(data)
+	return transfers, data, err
+}
+
+// EnableFutures 为子账户开通合约
+// 参数:
+//   - email: 子账户邮箱
+//
+// 返回值:
+//   - bool: 是否已开通合约
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (s *SubAccount) EnableFutures(email string) (bool, []byte, error) {
+	param := &url.Values{}
+	param.Set("email", email)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.EnableSubFuturesUri, param, nil)
+	if err != nil {
+		return false, data, err
+	}
+
+	enabled, err := s.UnmarshalOpts.EnableSubFuturesResponseUnmarshaler(data)
+	return enabled, data, err
+}
+
+// GetApiRestriction 查询子账户API Key的IP白名单
+// 参数:
+//   - email: 子账户邮箱
+//   - apiKey: 子账户的API Key
+//
+// 返回值:
+//   - *model.SubAccountApiRestriction: IP白名单配置
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 仅适用于母账户可管理的子账户API Key
+func (s *SubAccount) GetApiRestriction(email, apiKey string) (*model.SubAccountApiRestriction, []byte, error) {
+	param := &url.Values{}
+	param.Set("email", email)
+	param.Set("subAccountApiKey", apiKey)
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodGet, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.SubApiRestrictionUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	restriction, err := s.UnmarshalOpts.SubApiRestrictionResponseUnmarshaler(data)
+	return restriction, data, err
+}
+
+// SetApiRestriction 设置子账户API Key的IP白名单
+// 参数:
+//   - email: 子账户邮箱
+//   - apiKey: 子账户的API Key
+//   - ips: IP白名单，为空时关闭IP限制
+//
+// 返回值:
+//   - *model.SubAccountApiRestriction: 设置后的IP白名单配置
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (s *SubAccount) SetApiRestriction(email, apiKey string, ips []string) (*model.SubAccountApiRestriction, []byte, error) {
+	param := &url.Values{}
+	param.Set("email", email)
+	param.Set("subAccountApiKey", apiKey)
+	if len(ips) > 0 {
+		param.Set("status", "2") //开启IP限制
+		param.Set("ipAddress", strings.Join(ips, ","))
+	} else {
+		param.Set("status", "1") //关闭IP限制
+	}
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodPost, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.SetSubApiRestrictionUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	restriction, err := s.UnmarshalOpts.SubApiRestrictionResponseUnmarshaler(data)
+	return restriction, data, err
+}
+
+// DeleteApiRestrictionIps 从子账户API Key的IP白名单中删除IP
+// 参数:
+//   - email: 子账户邮箱
+//   - apiKey: 子账户的API Key
+//   - ips: 需要删除的IP
+//
+// 返回值:
+//   - *model.SubAccountApiRestriction: 删除后的IP白名单配置
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (s *SubAccount) DeleteApiRestrictionIps(email, apiKey string, ips []string) (*model.SubAccountApiRestriction, []byte, error) {
+	if len(ips) == 0 {
+		return nil, nil, errors.New("ips can not be empty")
+	}
+
+	param := &url.Values{}
+	param.Set("email", email)
+	param.Set("subAccountApiKey", apiKey)
+	param.Set("ipAddress", strings.Join(ips, ","))
+
+	data, err := s.AuthClient.DoAuthRequest(http.MethodDelete, s.AuthClient.UriOpts.Endpoint+s.AuthClient.UriOpts.DeleteSubApiIpUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	restriction, err := s.UnmarshalOpts.SubApiRestrictionResponseUnmarshaler(data)
+	return restriction, data, err
+}
@@ -0,0 +1,228 @@
+package subaccount
+
+import (
+	"errors"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+)
+
+// UnmarshalGetSubAccountsResponse 解析子账户列表响应数据
+// 参数:
+//   - data: API响应的原始数据，子账户在subAccounts数组中
+//
+// 返回值:
+//   - []model.SubAccount: 子账户列表
+//   - error: 错误信息
+func UnmarshalGetSubAccountsResponse(data []byte) ([]model.SubAccount, error) {
+	var accounts []model.SubAccount
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var acc model.SubAccount
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "email":
+				acc.Email = valStr
+			case "isFreeze":
+				acc.IsFreeze = cast.ToBool(valStr)
+			case "isManagedSubAccount":
+				acc.IsManaged = cast.ToBool(valStr)
+			case "isAssetManagementSubAccount":
+				acc.IsAssetManagement = cast.ToBool(valStr)
+			case "createTime":
+				acc.CreateTime = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		accounts = append(accounts, acc)
+	}, "subAccounts")
+	if errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return accounts, nil
+	}
+	return accounts, err
+}
+
+// UnmarshalGetSubAssetsResponse 解析子账户现货资产响应数据
+// 参数:
+//   - data: API响应的原始数据，资产在balances数组中
+//
+// 返回值:
+//   - map[string]model.Account: 现货资产，键为币种
+//   - error: 错误信息
+func UnmarshalGetSubAssetsResponse(data []byte) (map[string]model.Account, error) {
+	var accounts = make(map[string]model.Account, 6)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var acc model.Account
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "free":
+				acc.AvailableBalance = cast.ToFloat64(valStr)
+			case "locked":
+				acc.FrozenBalance = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		acc.Balance = acc.AvailableBalance + acc.FrozenBalance
+		accounts[acc.Coin] = acc
+	}, "balances")
+	return accounts, err
+}
+
+// UnmarshalGetSubFuturesAccountResponse 解析子账户合约资产响应数据
+// 参数:
+//   - data: API响应的原始数据，U本位为futureAccountResp，币本位为deliveryAccountResp
+//
+// 返回值:
+//   - map[string]model.FuturesAccount: 合约资产，键为币种
+//   - error: 错误信息
+func UnmarshalGetSubFuturesAccountResponse(data []byte) (map[string]model.FuturesAccount, error) {
+	resp, _, _, err := jsonparser.Get(data, "futureAccountResp")
+	if err != nil {
+		resp, _, _, err = jsonparser.Get(data, "deliveryAccountResp")
+		if err != nil {
+			return nil, errors.New(string(data))
+		}
+	}
+
+	var accounts = make(map[string]model.FuturesAccount, 4)
+	_, err = jsonparser.ArrayEach(resp, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var (
+			acc         model.FuturesAccount
+			maintMargin float64
+		)
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "asset":
+				acc.Coin = valStr
+			case "marginBalance":
+				acc.Eq = cast.ToFloat64(valStr)
+			case "maxWithdrawAmount":
+				acc.AvailEq = cast.ToFloat64(valStr)
+			case "initialMargin":
+				acc.FrozenBal = cast.ToFloat64(valStr)
+			case "unrealizedProfit":
+				acc.Upl = cast.ToFloat64(valStr)
+			case "maintenanceMargin":
+				maintMargin = cast.ToFloat64(valStr)
+			}
+			return nil
+		})
+		if acc.Eq > 0 {
+			acc.MgnRatio = maintMargin / acc.Eq
+		}
+		accounts[acc.Coin] = acc
+	}, "assets")
+	return accounts, err
+}
+
+// UnmarshalSubTransferResponse 解析母子账户划转响应数据
+// 参数:
+//   - data: API响应的原始数据，如{"tranId":11945860693,"clientTranId":"test"}
+//
+// 返回值:
+//   - string: 划转ID
+//   - error: 错误信息
+func UnmarshalSubTransferResponse(data []byte) (string, error) {
+	tranId, _, _, err := jsonparser.Get(data, "tranId")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return string(tranId), nil
+}
+
+// UnmarshalGetSubTransferHistoryResponse 解析母子账户划转记录响应数据
+// 参数:
+//   - data: API响应的原始数据，记录在result数组中
+//
+// 返回值:
+//   - []model.SubAccountTransfer: 划转记录
+//   - error: 错误信息
+func UnmarshalGetSubTransferHistoryResponse(data []byte) ([]model.SubAccountTransfer, error) {
+	var transfers []model.SubAccountTransfer
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var t model.SubAccountTransfer
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "tranId":
+				t.TranId = valStr
+			case "clientTranId":
+				t.ClientTranId = valStr
+			case "fromEmail":
+				t.FromEmail = valStr
+			case "toEmail":
+				t.ToEmail = valStr
+			case "fromAccountType":
+				t.FromAccountType = model.SubAccountType(valStr)
+			case "toAccountType":
+				t.ToAccountType = model.SubAccountType(valStr)
+			case "asset":
+				t.Coin = valStr
+			case "amount":
+				t.Amount = cast.ToFloat64(valStr)
+			case "status":
+				t.Status = valStr
+			case "createTimeStamp":
+				t.CreateTime = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		transfers = append(transfers, t)
+	}, "result")
+	if errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return transfers, nil
+	}
+	return transfers, err
+}
+
+// UnmarshalEnableSubFuturesResponse 解析子账户开通合约响应数据
+// 参数:
+//   - data: API响应的原始数据，如{"email":"xxx@test.com","isFuturesEnabled":true}
+//
+// 返回值:
+//   - bool: 是否已开通合约
+//   - error: 错误信息
+func UnmarshalEnableSubFuturesResponse(data []byte) (bool, error) {
+	enabled, err := jsonparser.GetBoolean(data, "isFuturesEnabled")
+	if err != nil {
+		return false, errors.New(string(data))
+	}
+	return enabled, nil
+}
+
+// UnmarshalSubApiRestrictionResponse 解析子账户API Key的IP白名单响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.SubAccountApiRestriction: IP白名单配置
+//   - error: 错误信息
+//
+// 注意:
+//   - 查询和删除接口返回ipRestrict字段，设置接口返回status字段(2表示开启IP限制)
+func UnmarshalSubApiRestrictionResponse(data []byte) (*model.SubAccountApiRestriction, error) {
+	var restriction model.SubAccountApiRestriction
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "apiKey":
+			restriction.ApiKey = valStr
+		case "ipRestrict":
+			restriction.IpRestrict = cast.ToBool(valStr)
+		case "status":
+			restriction.IpRestrict = valStr == "2"
+		case "ipList":
+			_, _ = jsonparser.ArrayEach(val, func(ip []byte, dataType jsonparser.ValueType, offset int, err error) {
+				restriction.IpList = append(restriction.IpList, string(ip))
+			})
+		case "updateTime":
+			restriction.UpdateTime = cast.ToInt64(valStr)
+		}
+		return nil
+	})
+	return &restriction, err
+}
@@ -0,0 +1,257 @@
+package wallet
+
+import (
+	"errors"
+	"github.com/buger/jsonparser"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/spf13/cast"
+	"time"
+)
+
+// applyTimeLayout 提现记录中applyTime、completeTime的时间格式(UTC)
+const applyTimeLayout = "2006-01-02 15:04:05"
+
+// UnmarshalGetDepositAddressResponse 解析充值地址响应数据
+// 参数:
+//   - data: API响应的原始数据
+//
+// 返回值:
+//   - *model.DepositAddress: 充值地址
+//   - error: 错误信息
+func UnmarshalGetDepositAddressResponse(data []byte) (*model.DepositAddress, error) {
+	var addr model.DepositAddress
+	err := jsonparser.ObjectEach(data, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+		valStr := string(val)
+		switch string(key) {
+		case "coin":
+			addr.Coin = valStr
+		case "address":
+			addr.Address = valStr
+		case "tag":
+			addr.Tag = valStr
+		case "url":
+			addr.Url = valStr
+		}
+		return nil
+	})
+	return &addr, err
+}
+
+// UnmarshalGetDepositHistoryResponse 解析充值记录响应数据
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - []model.Deposit: 充值记录
+//   - error: 错误信息
+func UnmarshalGetDepositHistoryResponse(data []byte) ([]model.Deposit, error) {
+	var deposits []model.Deposit
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var dep model.Deposit
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				dep.Id = valStr
+			case "coin":
+				dep.Coin = valStr
+			case "network":
+				dep.Network = valStr
+			case "amount":
+				dep.Amount = cast.ToFloat64(valStr)
+			case "address":
+				dep.Address = valStr
+			case "addressTag":
+				dep.Tag = valStr
+			case "txId":
+				dep.TxId = valStr
+			case "status":
+				dep.Status = model.DepositStatus(cast.ToInt(valStr))
+			case "confirmTimes":
+				dep.ConfirmTimes = valStr
+			case "insertTime":
+				dep.InsertTime = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		deposits = append(deposits, dep)
+	})
+	return deposits, err
+}
+
+// UnmarshalGetWithdrawHistoryResponse 解析提现记录响应数据
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - []model.Withdrawal: 提现记录
+//   - error: 错误信息
+//
+// 注意:
+//   - applyTime、completeTime为UTC时间字符串，转换为毫秒时间戳
+func UnmarshalGetWithdrawHistoryResponse(data []byte) ([]model.Withdrawal, error) {
+	var withdrawals []model.Withdrawal
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var wd model.Withdrawal
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "id":
+				wd.Id = valStr
+			case "withdrawOrderId":
+				wd.WithdrawOrderId = valStr
+			case "coin":
+				wd.Coin = valStr
+			case "network":
+				wd.Network = valStr
+			case "amount":
+				wd.Amount = cast.ToFloat64(valStr)
+			case "transactionFee":
+				wd.Fee = cast.ToFloat64(valStr)
+			case "address":
+				wd.Address = valStr
+			case "addressTag":
+				wd.Tag = valStr
+			case "txId":
+				wd.TxId = valStr
+			case "status":
+				wd.Status = model.WithdrawStatus(cast.ToInt(valStr))
+			case "applyTime":
+				wd.ApplyTime = parseApplyTime(valStr)
+			case "completeTime":
+				wd.CompleteTime = parseApplyTime(valStr)
+			}
+			return nil
+		})
+		withdrawals = append(withdrawals, wd)
+	})
+	return withdrawals, err
+}
+
+func parseApplyTime(s string) int64 {
+	tm, err := time.ParseInLocation(applyTimeLayout, s, time.UTC)
+	if err != nil {
+		return 0
+	}
+	return tm.UnixMilli()
+}
+
+// UnmarshalWithdrawResponse 解析提现响应数据
+// 参数:
+//   - data: API响应的原始数据，如{"id":"7213fea8e94b4a5593d507237e5a555b"}
+//
+// 返回值:
+//   - string: 提现ID
+//   - error: 错误信息
+func UnmarshalWithdrawResponse(data []byte) (string, error) {
+	id, err := jsonparser.GetString(data, "id")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return id, nil
+}
+
+// UnmarshalGetCoinNetworksResponse 解析币种信息响应数据
+// 参数:
+//   - data: API响应的原始数据(数组)
+//
+// 返回值:
+//   - map[string][]model.CoinNetwork: 网络信息，键为币种
+//   - error: 错误信息
+func UnmarshalGetCoinNetworksResponse(data []byte) (map[string][]model.CoinNetwork, error) {
+	var networks = make(map[string][]model.CoinNetwork, 500)
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		coin, _ := jsonparser.GetString(value, "coin")
+		var list []model.CoinNetwork
+		_, err = jsonparser.ArrayEach(value, func(netData []byte, dataType jsonparser.ValueType, offset int, err error) {
+			var n = model.CoinNetwork{Coin: coin}
+			err = jsonparser.ObjectEach(netData, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+				valStr := string(val)
+				switch string(key) {
+				case "network":
+					n.Network = valStr
+				case "name":
+					n.Name = valStr
+				case "isDefault":
+					n.IsDefault = cast.ToBool(valStr)
+				case "depositEnable":
+					n.DepositEnable = cast.ToBool(valStr)
+				case "withdrawEnable":
+					n.WithdrawEnable = cast.ToBool(valStr)
+				case "withdrawFee":
+					n.WithdrawFee = cast.ToFloat64(valStr)
+				case "withdrawMin":
+					n.WithdrawMin = cast.ToFloat64(valStr)
+				case "withdrawMax":
+					n.WithdrawMax = cast.ToFloat64(valStr)
+				case "withdrawIntegerMultiple":
+					n.WithdrawIntegerMultiple = cast.ToFloat64(valStr)
+				case "minConfirm":
+					n.MinConfirm = cast.ToInt(valStr)
+				case "unLockConfirm":
+					n.UnLockConfirm = cast.ToInt(valStr)
+				case "addressRegex":
+					n.AddressRegex = valStr
+				case "memoRegex":
+					n.MemoRegex = valStr
+				}
+				return nil
+			})
+			list = append(list, n)
+		}, "networkList")
+		networks[coin] = list
+	})
+	return networks, err
+}
+
+// UnmarshalTransferResponse 解析万向划转响应数据
+// 参数:
+//   - data: API响应的原始数据，如{"tranId":13526853623}
+//
+// 返回值:
+//   - string: 划转ID
+//   - error: 错误信息
+func UnmarshalTransferResponse(data []byte) (string, error) {
+	tranId, _, _, err := jsonparser.Get(data, "tranId")
+	if err != nil {
+		return "", errors.New(string(data))
+	}
+	return string(tranId), nil
+}
+
+// UnmarshalGetTransferHistoryResponse 解析万向划转记录响应数据
+// 参数:
+//   - data: API响应的原始数据，记录在rows数组中，无记录时没有rows字段
+//
+// 返回值:
+//   - []model.Transfer: 划转记录
+//   - error: 错误信息
+func UnmarshalGetTransferHistoryResponse(data []byte) ([]model.Transfer, error) {
+	var transfers []model.Transfer
+	_, err := jsonparser.ArrayEach(data, func(value []byte, dataType jsonparser.ValueType, offset int, err error) {
+		var t model.Transfer
+		err = jsonparser.ObjectEach(value, func(key []byte, val []byte, dataType jsonparser.ValueType, offset int) error {
+			valStr := string(val)
+			switch string(key) {
+			case "tranId":
+				t.TranId = valStr
+			case "asset":
+				t.Coin = valStr
+			case "amount":
+				t.Amount = cast.ToFloat64(valStr)
+			case "type":
+				t.Type = valStr
+			case "status":
+				t.Status = valStr
+			case "timestamp":
+				t.Time = cast.ToInt64(valStr)
+			}
+			return nil
+		})
+		transfers = append(transfers, t)
+	}, "rows")
+	if errors.Is(err, jsonparser.KeyPathNotFoundError) {
+		return transfers, nil
+	}
+	return transfers, err
+}
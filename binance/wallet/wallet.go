@@ -0,0 +1,311 @@
+package wallet
+
+import (
+	"github.com/nntaoli-project/goex/v2/binance/common"
+	"github.com/nntaoli-project/goex/v2/model"
+	"github.com/nntaoli-project/goex/v2/options"
+	"github.com/nntaoli-project/goex/v2/util"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Wallet 币安钱包API实现
+// 包含充值、提现、币种网络信息和万向划转接口，所有接口都需要API密钥
+type Wallet struct {
+	*common.AuthClient
+
+	UnmarshalOpts options.UnmarshalerOptions
+}
+
+// New 创建币安钱包API实例
+// 参数:
+//   - apiOpts: API选项，如API密钥、密钥等
+//
+// 返回值:
+//   - *Wallet: 币安钱包API实例
+//
+// 使用示例:
+//
+//	w := wallet.New(
+//	  options.WithApiKey("your-api-key"),
+//	  options.WithApiSecretKey("your-secret-key"))
+func New(apiOpts ...options.ApiOption) *Wallet {
+	w := &Wallet{
+		AuthClient: &common.AuthClient{
+			UriOpts: options.UriOptions{
+				Endpoint:              "https://api.binance.com",
+				GetDepositAddressUri:  "/sapi/v1/capital/deposit/address",
+				GetDepositHistoryUri:  "/sapi/v1/capital/deposit/hisrec",
+				GetWithdrawHistoryUri: "/sapi/v1/capital/withdraw/history",
+				WithdrawUri:           "/sapi/v1/capital/withdraw/apply",
+				GetCoinNetworksUri:    "/sapi/v1/capital/config/getall",
+				TransferUri:           "/sapi/v1/asset/transfer",
+			},
+		},
+		UnmarshalOpts: options.UnmarshalerOptions{
+			GetDepositAddressResponseUnmarshaler:  UnmarshalGetDepositAddressResponse,
+			GetDepositHistoryResponseUnmarshaler:  UnmarshalGetDepositHistoryResponse,
+			GetWithdrawHistoryResponseUnmarshaler: UnmarshalGetWithdrawHistoryResponse,
+			WithdrawResponseUnmarshaler:           UnmarshalWithdrawResponse,
+			GetCoinNetworksResponseUnmarshaler:    UnmarshalGetCoinNetworksResponse,
+			TransferResponseUnmarshaler:           UnmarshalTransferResponse,
+			GetTransferHistoryResponseUnmarshaler: UnmarshalGetTransferHistoryResponse,
+		},
+	}
+
+	for _, opt := range apiOpts {
+		opt(&w.AuthClient.ApiOpts)
+	}
+
+	return w
+}
+
+// WithUriOption 设置URI选项
+// 参数:
+//   - opts: URI选项函数
+//
+// 返回值:
+//   - *Wallet: 当前API实例，用于链式调用
+func (w *Wallet) WithUriOption(opts ...options.UriOption) *Wallet {
+	for _, opt := range opts {
+		opt(&w.AuthClient.UriOpts)
+	}
+	return w
+}
+
+// WithUnmarshalOption 设置反序列化选项
+// 参数:
+//   - opts: 反序列化选项函数
+//
+// 返回值:
+//   - *Wallet: 当前API实例，用于链式调用
+func (w *Wallet) WithUnmarshalOption(opts ...options.UnmarshalerOption) *Wallet {
+	for _, opt := range opts {
+		opt(&w.UnmarshalOpts)
+	}
+	return w
+}
+
+// GetDepositAddress 获取充值地址
+// 参数:
+//   - coin: 币种，如USDT
+//   - network: 网络，如TRX、ETH，为空时使用默认网络
+//
+// 返回值:
+//   - *model.DepositAddress: 充值地址，部分币种需要同时填写Tag
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (w *Wallet) GetDepositAddress(coin, network string) (*model.DepositAddress, []byte, error) {
+	param := &url.Values{}
+	param.Set("coin", coin)
+	if network != "" {
+		param.Set("network", network)
+	}
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodGet, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.GetDepositAddressUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	addr, err := w.UnmarshalOpts.GetDepositAddressResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if addr.Network == "" {
+		addr.Network = network
+	}
+
+	return addr, data, nil
+}
+
+// GetDepositHistory 获取充值记录
+// 参数:
+//   - coin: 币种，为空时返回所有币种
+//   - opts: 可选参数，如status、startTime、endTime、offset、limit
+//
+// 返回值:
+//   - []model.Deposit: 充值记录
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 不传时间时默认返回最近90天的记录，startTime和endTime间隔不能超过90天
+func (w *Wallet) GetDepositHistory(coin string, opts ...model.OptionParameter) ([]model.Deposit, []byte, error) {
+	param := &url.Values{}
+	if coin != "" {
+		param.Set("coin", coin)
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodGet, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.GetDepositHistoryUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	deposits, err := w.UnmarshalOpts.GetDepositHistoryResponseUnmarshaler(data)
+	return deposits, data, err
+}
+
+// GetWithdrawHistory 获取提现记录
+// 参数:
+//   - coin: 币种，为空时返回所有币种
+//   - opts: 可选参数，如withdrawOrderId、status、startTime、endTime、offset、limit
+//
+// 返回值:
+//   - []model.Withdrawal: 提现记录
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 不传时间时默认返回最近90天的记录，startTime和endTime间隔不能超过90天
+func (w *Wallet) GetWithdrawHistory(coin string, opts ...model.OptionParameter) ([]model.Withdrawal, []byte, error) {
+	param := &url.Values{}
+	if coin != "" {
+		param.Set("coin", coin)
+	}
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodGet, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.GetWithdrawHistoryUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	withdrawals, err := w.UnmarshalOpts.GetWithdrawHistoryResponseUnmarshaler(data)
+	return withdrawals, data, err
+}
+
+// Withdraw 提现
+// 参数:
+//   - coin: 币种
+//   - network: 提现网络，为空时使用默认网络，可通过GetCoinNetworks查询
+//   - address: 提现地址
+//   - amount: 提现数量
+//   - opts: 可选参数，如addressTag、OptionParameter{}.OrderClientID(自定义提现ID)
+//
+// 返回值:
+//   - string: 提现ID
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	id, _, err := w.Withdraw(model.USDT, "TRX", "Txxxx", 100)
+func (w *Wallet) Withdraw(coin, network, address string, amount float64, opts ...model.OptionParameter) (string, []byte, error) {
+	param := &url.Values{}
+	param.Set("coin", coin)
+	param.Set("address", address)
+	param.Set("amount", util.FloatToString(amount, 8))
+	if network != "" {
+		param.Set("network", network)
+	}
+
+	util.MergeOptionParams(param, opts...)
+	if cid := param.Get(model.Order_Client_ID__Opt_Key); cid != "" {
+		param.Set("withdrawOrderId", cid)
+		param.Del(model.Order_Client_ID__Opt_Key)
+	}
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodPost, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.WithdrawUri, param, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	id, err := w.UnmarshalOpts.WithdrawResponseUnmarshaler(data)
+	return id, data, err
+}
+
+// GetCoinNetworks 获取币种的充提网络信息
+// 参数:
+//   - coin: 币种，为空时返回所有币种
+//
+// 返回值:
+//   - map[string][]model.CoinNetwork: 网络信息，键为币种
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+func (w *Wallet) GetCoinNetworks(coin string) (map[string][]model.CoinNetwork, []byte, error) {
+	data, err := w.AuthClient.DoAuthRequest(http.MethodGet, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.GetCoinNetworksUri, &url.Values{}, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	networks, err := w.UnmarshalOpts.GetCoinNetworksResponseUnmarshaler(data)
+	if err != nil {
+		return nil, data, err
+	}
+
+	if coin != "" {
+		for c := range networks {
+			if !strings.EqualFold(c, coin) {
+				delete(networks, c)
+			}
+		}
+	}
+
+	return networks, data, nil
+}
+
+// Transfer 万向划转
+// 参数:
+//   - from: 转出账户，如model.WalletAccount_Spot
+//   - to: 转入账户，如model.WalletAccount_UMFuture
+//   - coin: 币种
+//   - amount: 划转数量
+//   - opts: 可选参数，如逐仓杠杆的fromSymbol、toSymbol
+//
+// 返回值:
+//   - string: 划转ID
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	tranId, _, err := w.Transfer(model.WalletAccount_Spot, model.WalletAccount_UMFuture, model.USDT, 1000)
+func (w *Wallet) Transfer(from, to model.WalletAccountType, coin string, amount float64, opts ...model.OptionParameter) (string, []byte, error) {
+	param := &url.Values{}
+	param.Set("type", model.TransferType(from, to))
+	param.Set("asset", coin)
+	param.Set("amount", util.FloatToString(amount, 8))
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodPost, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.TransferUri, param, nil)
+	if err != nil {
+		return "", data, err
+	}
+
+	tranId, err := w.UnmarshalOpts.TransferResponseUnmarshaler(data)
+	return tranId, data, err
+}
+
+// GetTransferHistory 获取万向划转记录
+// 参数:
+//   - from: 转出账户
+//   - to: 转入账户
+//   - opts: 可选参数，如startTime、endTime、current、size
+//
+// 返回值:
+//   - []model.Transfer: 划转记录
+//   - []byte: API响应原始数据
+//   - error: 错误信息
+//
+// 注意:
+//   - 默认每页返回100条，通过current参数翻页
+func (w *Wallet) GetTransferHistory(from, to model.WalletAccountType, opts ...model.OptionParameter) ([]model.Transfer, []byte, error) {
+	param := &url.Values{}
+	param.Set("type", model.TransferType(from, to))
+	param.Set("size", "100")
+
+	util.MergeOptionParams(param, opts...)
+
+	data, err := w.AuthClient.DoAuthRequest(http.MethodGet, w.AuthClient.UriOpts.Endpoint+w.AuthClient.UriOpts.TransferUri, param, nil)
+	if err != nil {
+		return nil, data, err
+	}
+
+	transfers, err := w.UnmarshalOpts.GetTransferHistoryResponseUnmarshaler(data)
+	return transfers, data, err
+}
@@ -77,14 +77,32 @@ const (
 	OrderType_Limit    OrderType = "limit"
 	OrderType_Market   OrderType = "market"
 	OrderType_opponent OrderType = "opponent"
+
+	// 期货条件单
+	OrderType_Stop               OrderType = "stop"                 //止损限价单，需要stopPrice
+	OrderType_StopMarket         OrderType = "stop_market"          //止损市价单，需要stopPrice
+	OrderType_TakeProfit         OrderType = "take_profit"          //止盈限价单，需要stopPrice
+	OrderType_TakeProfitMarket   OrderType = "take_profit_market"   //止盈市价单，需要stopPrice
+	OrderType_TrailingStopMarket OrderType = "trailing_stop_market" //跟踪止损单，需要callbackRate
+
+	// 现货条件单
+	OrderType_StopLossLimit   OrderType = "stop_loss_limit"   //止损限价单，需要stopPrice
+	OrderType_TakeProfitLimit OrderType = "take_profit_limit" //止盈限价单，需要stopPrice
+	OrderType_LimitMaker      OrderType = "limit_maker"       //只做maker的限价单，OCO订单的止盈腿
 )
 
 // 订单类型常量，用于WebSocket处理
 const (
 	LIMIT       = OrderType_Limit
 	MARKET      = OrderType_Market
-	STOP        = "stop"
-	STOP_MARKET = "stop_market"
+	STOP        = OrderType_Stop
+	STOP_MARKET = OrderType_StopMarket
+)
+
+// 条件单触发价格类型
+const (
+	WorkingType_MarkPrice     = "MARK_PRICE"     //标记价格
+	WorkingType_ContractPrice = "CONTRACT_PRICE" //合约最新价
 )
 
 // coin const list
@@ -195,10 +213,69 @@ const (
 )
 
 const (
-	Order_Client_ID__Opt_Key = "OrderClientID"
+	Order_Client_ID__Opt_Key      = "OrderClientID"
+	Orig_Order_Client_ID__Opt_Key = "OrigOrderClientID" //修改订单时原订单的客户端ID，见OptionParameter{}.OrigOrderClientID
+)
+
+// 条件单参数key，与币安接口参数名一致
+const (
+	Stop_Price__Opt_Key       = "stopPrice"
+	Activation_Price__Opt_Key = "activationPrice"
+	Callback_Rate__Opt_Key    = "callbackRate"
+	Working_Type__Opt_Key     = "workingType"
+	Price_Protect__Opt_Key    = "priceProtect"
+	Close_Position__Opt_Key   = "closePosition"
+)
+
+// 合约alias，通过NewCurrencyPair的opts传入，如model.OptionParameter{Key: model.Contract_Alias__Opt_Key, Value: model.THIS_QUARTER_CONTRACT}
+const (
+	Contract_Alias__Opt_Key = "contractAlias"
+
+	PERPETUAL_CONTRACT    = "PERPETUAL"    //永续合约
+	THIS_QUARTER_CONTRACT = "this_quarter" //当季交割合约
+	NEXT_QUARTER_CONTRACT = "next_quarter" //次季交割合约
+)
+
+// 杠杆订单借还款类型，通过model.OptionParameter{}.SideEffectType传入
+const (
+	Side_Effect_Type__Opt_Key = "sideEffectType"
+
+	SideEffectType_NoSideEffect    = "NO_SIDE_EFFECT"    //普通订单
+	SideEffectType_MarginBuy       = "MARGIN_BUY"        //自动借款
+	SideEffectType_AutoRepay       = "AUTO_REPAY"        //自动还款
+	SideEffectType_AutoBorrowRepay = "AUTO_BORROW_REPAY" //自动借款并还款
+)
+
+// 测试下单参数key，通过model.OptionParameter{}.TestOrder传入，只校验参数、签名和交易规则，不会真正下单
+const (
+	Test_Order__Opt_Key               = "testOrder"
+	Compute_Commission_Rates__Opt_Key = "computeCommissionRates"
+)
+
+// 精确十进制下单参数key，通过model.OptionParameter{}.ExactQty/ExactPrice传入
+// 不是币安接口参数，下单时规整后转换为quantity/price，不需要价格的订单类型不发送price
+const (
+	Exact_Qty__Opt_Key   = "exactQty"
+	Exact_Price__Opt_Key = "exactPrice"
 )
 
 const (
 	TWO_WAY_POSITION_MODE = "TWO_WAY_POSITION_MODE"
 	ONE_WAY_POSITION_MODE = "ONE_WAY_POSITION_MODE"
 )
+
+// 保证金模式
+const (
+	ISOLATED_MARGIN_TYPE = "ISOLATED" //逐仓
+	CROSSED_MARGIN_TYPE  = "CROSSED"  //全仓
+)
+
+// 期货资金流水类型
+const (
+	IncomeType_Transfer       IncomeType = "TRANSFER"        //划转
+	IncomeType_RealizedPnl    IncomeType = "REALIZED_PNL"    //已实现盈亏
+	IncomeType_FundingFee     IncomeType = "FUNDING_FEE"     //资金费
+	IncomeType_Commission     IncomeType = "COMMISSION"      //手续费
+	IncomeType_InsuranceClear IncomeType = "INSURANCE_CLEAR" //强平清算
+	IncomeType_WelcomeBonus   IncomeType = "WELCOME_BONUS"   //赠金
+)
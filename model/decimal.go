@@ -0,0 +1,280 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+var exactDecimalEnabled atomic.Bool
+
+// EnableExactDecimal 开启或关闭精确十进制解析
+// 参数:
+//   - enabled: 是否开启
+//
+// 注意:
+//   - 默认关闭，开启后现货和U本位合约的Ticker、深度、K线、订单、成交明细解析函数会额外填充模型的Exact字段
+//   - 原有float64字段保持不变，关闭时Exact为nil，不影响现有代码和性能
+//
+// 使用示例:
+//
+//	model.EnableExactDecimal(true)
+//	fills, _, _ := prvApi.GetFills(pair)
+//	var total model.Decimal
+//	for _, f := range fills {
+//	  total = total.Add(f.Exact.Commission)
+//	}
+func EnableExactDecimal(enabled bool) {
+	exactDecimalEnabled.Store(enabled)
+}
+
+// ExactDecimalEnabled 是否开启了精确十进制解析
+func ExactDecimalEnabled() bool {
+	return exactDecimalEnabled.Load()
+}
+
+// Decimal 精确十进制数，值为coef*10^(-scale)，零值表示0
+// 用于避免float64在PEPE、SHIB等小价格资产以及累加成交、手续费时的表示误差
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// NewDecimal 从十进制字符串创建Decimal，支持币安返回的"0.00001234"、"-1.5"以及"1e-8"格式，不丢失精度
+func NewDecimal(s string) (Decimal, error) {
+	str := strings.TrimSpace(s)
+	if str == "" {
+		return Decimal{}, errors.New("empty decimal string")
+	}
+
+	exp := 0
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		e, err := strconv.Atoi(str[i+1:])
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal: %s", s)
+		}
+		exp, str = e, str[:i]
+	}
+
+	intPart, fracPart := str, ""
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		intPart, fracPart = str[:i], str[i+1:]
+	}
+	if strings.ContainsAny(fracPart, "+-") {
+		return Decimal{}, fmt.Errorf("invalid decimal: %s", s)
+	}
+
+	coef, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal: %s", s)
+	}
+
+	scale := len(fracPart) - exp
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustDecimal 从十进制字符串创建Decimal，格式错误时panic，用于常量
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromFloat 使用float64的最短十进制表示创建Decimal，如0.1得到精确的0.1
+func NewDecimalFromFloat(f float64) Decimal {
+	d, _ := NewDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	return d
+}
+
+// NewDecimalFromInt 从整数创建Decimal
+func NewDecimalFromInt(i int64) Decimal {
+	return Decimal{coef: big.NewInt(i)}
+}
+
+// String 返回不带多余末尾0的十进制字符串，可以直接作为下单参数
+func (d Decimal) String() string {
+	if d.Sign() == 0 {
+		return "0"
+	}
+
+	s := new(big.Int).Abs(d.coef).String()
+	if scale := int(d.scale); scale > 0 {
+		if len(s) <= scale {
+			s = strings.Repeat("0", scale-len(s)+1) + s
+		}
+		s = s[:len(s)-scale] + "." + s[len(s)-scale:]
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+
+	if d.coef.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// Float64 转换为float64，可能损失精度
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// Sign 返回-1、0或1
+func (d Decimal) Sign() int {
+	if d.coef == nil {
+		return 0
+	}
+	return d.coef.Sign()
+}
+
+// IsZero 是否为0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp 比较大小，d<o返回-1，d==o返回0，d>o返回1
+func (d Decimal) Cmp(o Decimal) int {
+	a, b := align(d, o)
+	return a.Cmp(b)
+}
+
+// Equal 数值是否相等，1.10与1.1相等
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Neg 取相反数
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.bigInt()), scale: d.scale}
+}
+
+// Add 加法
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b := align(d, o)
+	return Decimal{coef: a.Add(a, b), scale: max(d.scale, o.scale)}
+}
+
+// Sub 减法
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b := align(d, o)
+	return Decimal{coef: a.Sub(a, b), scale: max(d.scale, o.scale)}
+}
+
+// Mul 乘法，结果的小数位数为两者之和
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.bigInt(), o.bigInt()), scale: d.scale + o.scale}
+}
+
+// Div 除法，结果保留places位小数并四舍五入，places为负数时取整到10^(-places)，o为0时panic
+func (d Decimal) Div(o Decimal, places int32) Decimal {
+	num := new(big.Int).Mul(d.bigInt(), pow10(int(max(places, 0)+o.scale)))
+	den := new(big.Int).Mul(o.bigInt(), pow10(int(d.scale+max(-places, 0))))
+	return withPlaces(quoRound(num, den), places)
+}
+
+// Round 四舍五入保留places位小数，places为负数时取整到10^(-places)，如1234.Round(-1)为1230
+func (d Decimal) Round(places int32) Decimal {
+	if d.scale <= places {
+		return d
+	}
+	return withPlaces(quoRound(d.bigInt(), pow10(int(d.scale-places))), places)
+}
+
+// Truncate 截断保留places位小数(向0取整)，places为负数时截断到10^(-places)，如1234.Truncate(-2)为1200
+func (d Decimal) Truncate(places int32) Decimal {
+	if d.scale <= places {
+		return d
+	}
+	return withPlaces(new(big.Int).Quo(d.bigInt(), pow10(int(d.scale-places))), places)
+}
+
+// FloorToStep 向下取整为step的整数倍，如数量按stepSize规整，step小于等于0时原样返回
+func (d Decimal) FloorToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	a, b := align(d, step)
+	return Decimal{coef: a.Div(a, b)}.Mul(step) //big.Int.Div为欧几里得除法，除数为正数时向负无穷取整
+}
+
+// RoundToStep 四舍五入为step的整数倍，如价格按tickSize规整，step小于等于0时原样返回
+func (d Decimal) RoundToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	a, b := align(d, step)
+	return Decimal{coef: quoRound(a, b)}.Mul(step)
+}
+
+// MarshalJSON 序列化为字符串，与币安接口的数字格式一致
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON 支持字符串和数字两种格式，null解析为0
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		*d = Decimal{}
+		return nil
+	}
+
+	v, err := NewDecimal(strings.Trim(s, `"`))
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+func (d Decimal) bigInt() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// withPlaces 由保留places位小数后的系数创建Decimal，places为负数时放大系数，保证scale不为负数
+func withPlaces(coef *big.Int, places int32) Decimal {
+	if places < 0 {
+		return Decimal{coef: coef.Mul(coef, pow10(int(-places)))}
+	}
+	return Decimal{coef: coef, scale: places}
+}
+
+// align 把两个数放大到相同的小数位数，返回新的系数
+func align(a, b Decimal) (*big.Int, *big.Int) {
+	x, y := new(big.Int).Set(a.bigInt()), new(big.Int).Set(b.bigInt())
+	if a.scale < b.scale {
+		x.Mul(x, pow10(int(b.scale-a.scale)))
+	} else if b.scale < a.scale {
+		y.Mul(y, pow10(int(a.scale-b.scale)))
+	}
+	return x, y
+}
+
+// quoRound 整数除法，四舍五入(远离0)
+func quoRound(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(r.Abs(r), big.NewInt(2)).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
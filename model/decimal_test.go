@@ -0,0 +1,139 @@
+package model
+
+import "testing"
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0.00001234", want: "0.00001234"},
+		{in: "-1.5", want: "-1.5"},
+		{in: "1.50000000", want: "1.5"},
+		{in: "1e-8", want: "0.00000001"},
+		{in: "1.2E3", want: "1200"},
+		{in: " 42 ", want: "42"},
+		{in: "-0.0", want: "0"},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.-2", wantErr: true},
+		{in: "1ex", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		d, err := NewDecimal(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("NewDecimal(%q) expected error, got %s", tt.in, d)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("NewDecimal(%q) error = %v", tt.in, err)
+			continue
+		}
+		if got := d.String(); got != tt.want {
+			t.Errorf("NewDecimal(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"add", MustDecimal("0.1").Add(MustDecimal("0.2")), "0.3"},
+		{"sub", MustDecimal("1").Sub(MustDecimal("0.00000001")), "0.99999999"},
+		{"mul", MustDecimal("0.00001234").Mul(MustDecimal("1000000")), "12.34"},
+		{"div", MustDecimal("1").Div(MustDecimal("3"), 4), "0.3333"},
+		{"div round half up", MustDecimal("2").Div(MustDecimal("3"), 2), "0.67"},
+		{"div negative", MustDecimal("-2").Div(MustDecimal("3"), 2), "-0.67"},
+		{"div negative places", MustDecimal("1000").Div(MustDecimal("3"), -1), "330"},
+		{"from float", NewDecimalFromFloat(0.1), "0.1"},
+		{"from int", NewDecimalFromInt(-7), "-7"},
+		{"zero value", Decimal{}, "0"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalRoundAndTruncate(t *testing.T) {
+	tests := []struct {
+		in        string
+		places    int32
+		wantRound string
+		wantTrunc string
+	}{
+		{"1.2345", 2, "1.23", "1.23"},
+		{"1.235", 2, "1.24", "1.23"},
+		{"-1.235", 2, "-1.24", "-1.23"},
+		{"1.5", 0, "2", "1"},
+		{"-1.5", 0, "-2", "-1"},
+		{"1.2", 4, "1.2", "1.2"},
+		{"1234", -1, "1230", "1230"},
+		{"1235", -1, "1240", "1230"},
+		{"1234.56", -2, "1200", "1200"},
+		{"-1250", -2, "-1300", "-1200"},
+		{"49", -2, "0", "0"},
+	}
+
+	for _, tt := range tests {
+		d := MustDecimal(tt.in)
+		if got := d.Round(tt.places).String(); got != tt.wantRound {
+			t.Errorf("%s.Round(%d) = %s, want %s", tt.in, tt.places, got, tt.wantRound)
+		}
+		if got := d.Truncate(tt.places).String(); got != tt.wantTrunc {
+			t.Errorf("%s.Truncate(%d) = %s, want %s", tt.in, tt.places, got, tt.wantTrunc)
+		}
+	}
+}
+
+func TestDecimalToStep(t *testing.T) {
+	tests := []struct {
+		in        string
+		step      string
+		wantFloor string
+		wantRound string
+	}{
+		{"1.23456", "0.001", "1.234", "1.235"},
+		{"0.000012345", "0.00000001", "0.00001234", "0.00001235"},
+		{"1000000.7", "1", "1000000", "1000001"},
+		{"123.456", "10", "120", "120"},
+		{"1.75", "0.5", "1.5", "2"},
+		{"-1.25", "0.1", "-1.3", "-1.3"},
+		{"1.23", "0", "1.23", "1.23"},
+	}
+
+	for _, tt := range tests {
+		d, step := MustDecimal(tt.in), MustDecimal(tt.step)
+		if got := d.FloorToStep(step).String(); got != tt.wantFloor {
+			t.Errorf("%s.FloorToStep(%s) = %s, want %s", tt.in, tt.step, got, tt.wantFloor)
+		}
+		if got := d.RoundToStep(step).String(); got != tt.wantRound {
+			t.Errorf("%s.RoundToStep(%s) = %s, want %s", tt.in, tt.step, got, tt.wantRound)
+		}
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	for _, in := range []string{`"0.00001234"`, `0.00001234`} {
+		if err := d.UnmarshalJSON([]byte(in)); err != nil {
+			t.Fatalf("UnmarshalJSON(%s) error = %v", in, err)
+		}
+		if got, _ := d.MarshalJSON(); string(got) != `"0.00001234"` {
+			t.Errorf("MarshalJSON after UnmarshalJSON(%s) = %s", in, got)
+		}
+	}
+
+	if err := d.UnmarshalJSON([]byte("null")); err != nil || !d.IsZero() {
+		t.Errorf("UnmarshalJSON(null) = %s, %v, want 0", d, err)
+	}
+}
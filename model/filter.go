@@ -0,0 +1,26 @@
+package model
+
+// SymbolFilters 交易对的交易规则过滤器，来自交易所信息的filters，字段为0表示交易所未设置该限制
+type SymbolFilters struct {
+	MinPrice float64 `json:"min_price,omitempty"` // PRICE_FILTER 最低价格
+	MaxPrice float64 `json:"max_price,omitempty"` // PRICE_FILTER 最高价格
+	TickSize float64 `json:"tick_size,omitempty"` // PRICE_FILTER 价格最小变动单位，可以是0.5、10这类非10的负整数次幂
+
+	MinQty   float64 `json:"min_qty,omitempty"`   // LOT_SIZE 最小数量
+	MaxQty   float64 `json:"max_qty,omitempty"`   // LOT_SIZE 最大数量
+	StepSize float64 `json:"step_size,omitempty"` // LOT_SIZE 数量最小变动单位
+
+	MarketMinQty   float64 `json:"market_min_qty,omitempty"`   // MARKET_LOT_SIZE 市价单最小数量
+	MarketMaxQty   float64 `json:"market_max_qty,omitempty"`   // MARKET_LOT_SIZE 市价单最大数量
+	MarketStepSize float64 `json:"market_step_size,omitempty"` // MARKET_LOT_SIZE 市价单数量最小变动单位
+
+	MinNotional      float64 `json:"min_notional,omitempty"`        // MIN_NOTIONAL/NOTIONAL 最小名义价值(价格*数量)
+	MaxNotional      float64 `json:"max_notional,omitempty"`        // NOTIONAL 最大名义价值
+	ApplyMinToMarket bool    `json:"apply_min_to_market,omitempty"` // 最小名义价值是否适用于市价单
+	ApplyMaxToMarket bool    `json:"apply_max_to_market,omitempty"` // 最大名义价值是否适用于市价单
+
+	BidMultiplierUp   float64 `json:"bid_multiplier_up,omitempty"`   // PERCENT_PRICE(_BY_SIDE) 买单价格上限倍数
+	BidMultiplierDown float64 `json:"bid_multiplier_down,omitempty"` // PERCENT_PRICE(_BY_SIDE) 买单价格下限倍数
+	AskMultiplierUp   float64 `json:"ask_multiplier_up,omitempty"`   // PERCENT_PRICE(_BY_SIDE) 卖单价格上限倍数
+	AskMultiplierDown float64 `json:"ask_multiplier_down,omitempty"` // PERCENT_PRICE(_BY_SIDE) 卖单价格下限倍数
+}
@@ -1,12 +1,15 @@
 package model
 
 import (
+	"strconv"
+	"strings"
 	"time"
 )
 
 type OrderType string
 type OrderSide string
 type KlinePeriod string
+type IncomeType string
 
 type OrderStatus int
 
@@ -37,6 +40,61 @@ func (OptionParameter) OrderClientID(cid string) OptionParameter {
 	}
 }
 
+// OrigOrderClientID 修改订单(如现货AmendOrder)时指定原订单的客户端ID，新订单的客户端ID仍通过OrderClientID传入
+func (OptionParameter) OrigOrderClientID(cid string) OptionParameter {
+	return OptionParameter{Key: Orig_Order_Client_ID__Opt_Key, Value: cid}
+}
+
+// StopPrice 条件单触发价格
+func (OptionParameter) StopPrice(price float64) OptionParameter {
+	return OptionParameter{Key: Stop_Price__Opt_Key, Value: strconv.FormatFloat(price, 'f', -1, 64)}
+}
+
+// ActivationPrice 跟踪止损单激活价格
+func (OptionParameter) ActivationPrice(price float64) OptionParameter {
+	return OptionParameter{Key: Activation_Price__Opt_Key, Value: strconv.FormatFloat(price, 'f', -1, 64)}
+}
+
+// CallbackRate 跟踪止损单回调幅度，单位为百分比，如1表示1%
+func (OptionParameter) CallbackRate(rate float64) OptionParameter {
+	return OptionParameter{Key: Callback_Rate__Opt_Key, Value: strconv.FormatFloat(rate, 'f', -1, 64)}
+}
+
+// WorkingType 条件单触发价格类型: WorkingType_MarkPrice / WorkingType_ContractPrice
+func (OptionParameter) WorkingType(ty string) OptionParameter {
+	return OptionParameter{Key: Working_Type__Opt_Key, Value: ty}
+}
+
+// PriceProtect 条件单是否开启价格保护
+func (OptionParameter) PriceProtect(protect bool) OptionParameter {
+	return OptionParameter{Key: Price_Protect__Opt_Key, Value: strings.ToUpper(strconv.FormatBool(protect))}
+}
+
+// ClosePosition 触发后是否全部平仓，仅支持STOP_MARKET和TAKE_PROFIT_MARKET
+func (OptionParameter) ClosePosition(closePosition bool) OptionParameter {
+	return OptionParameter{Key: Close_Position__Opt_Key, Value: strconv.FormatBool(closePosition)}
+}
+
+// SideEffectType 杠杆订单的借还款类型: SideEffectType_MarginBuy / SideEffectType_AutoRepay 等
+func (OptionParameter) SideEffectType(ty string) OptionParameter {
+	return OptionParameter{Key: Side_Effect_Type__Opt_Key, Value: ty}
+}
+
+// ExactQty 使用精确十进制数量下单，覆盖CreateOrder的qty参数，按stepSize向下取整后发送并校验交易规则
+func (OptionParameter) ExactQty(qty Decimal) OptionParameter {
+	return OptionParameter{Key: Exact_Qty__Opt_Key, Value: qty.String()}
+}
+
+// ExactPrice 使用精确十进制价格下单，覆盖CreateOrder的price参数，按tickSize四舍五入后发送并校验交易规则，市价类订单忽略
+func (OptionParameter) ExactPrice(price Decimal) OptionParameter {
+	return OptionParameter{Key: Exact_Price__Opt_Key, Value: price.String()}
+}
+
+// TestOrder 单次下单是否使用测试下单接口，优先于实例的测试模式
+func (OptionParameter) TestOrder(test bool) OptionParameter {
+	return OptionParameter{Key: Test_Order__Opt_Key, Value: strconv.FormatBool(test)}
+}
+
 type CurrencyPair struct {
 	Symbol               string  `json:"symbol,omitempty"`          //交易对
 	BaseSymbol           string  `json:"base_symbol,omitempty"`     //币种
@@ -51,6 +109,9 @@ type CurrencyPair struct {
 	SettlementCurrency   string  `json:"settlement_currency,omitempty"`    //结算币
 	ContractAlias        string  `json:"contract_alias,omitempty"`         //交割合约alias
 	ContractDeliveryDate int64   `json:"contract_delivery_date,omitempty"` //合约交割日期
+	ContractOnboardDate  int64   `json:"contract_onboard_date,omitempty"`  //合约上线日期
+
+	Filters SymbolFilters `json:"filters"` //交易规则过滤器，用于下单前规整价格数量并在本地校验
 }
 
 //func (pair CurrencyPair) String() string {
@@ -74,11 +135,29 @@ type Ticker struct {
 	Vol       float64      `json:"v"`
 	Percent   float64      `json:"percent"`
 	Timestamp int64        `json:"t"`
+	Exact     *TickerExact `json:"exact,omitempty"` //精确十进制值，开启model.EnableExactDecimal后填充
+}
+
+// TickerExact Ticker价格和成交量的精确十进制值
+type TickerExact struct {
+	Last Decimal `json:"l"`
+	Buy  Decimal `json:"b"`
+	Sell Decimal `json:"s"`
+	High Decimal `json:"h"`
+	Low  Decimal `json:"lw"`
+	Vol  Decimal `json:"v"`
 }
 
 type DepthItem struct {
-	Price  float64 `json:"price"`
-	Amount float64 `json:"amount"`
+	Price  float64         `json:"price"`
+	Amount float64         `json:"amount"`
+	Exact  *DepthItemExact `json:"exact,omitempty"` //精确十进制值，开启model.EnableExactDecimal后填充
+}
+
+// DepthItemExact 深度档位价格和数量的精确十进制值
+type DepthItemExact struct {
+	Price  Decimal `json:"price"`
+	Amount Decimal `json:"amount"`
 }
 
 type DepthItems []DepthItem
@@ -110,6 +189,22 @@ type Kline struct {
 	High      float64      `json:"h"`
 	Low       float64      `json:"l"`
 	Vol       float64      `json:"v"`
+	Exact     *KlineExact  `json:"exact,omitempty"` //精确十进制值，开启model.EnableExactDecimal后填充
+}
+
+// KlineExact K线价格和成交量的精确十进制值
+type KlineExact struct {
+	Open  Decimal `json:"o"`
+	Close Decimal `json:"s"`
+	High  Decimal `json:"h"`
+	Low   Decimal `json:"l"`
+	Vol   Decimal `json:"v"`
+}
+
+// KlineGap 缺失的K线区间，Start和End为缺失的首根和末根K线的开盘时间(毫秒)
+type KlineGap struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
 }
 
 type Order struct {
@@ -128,6 +223,79 @@ type Order struct {
 	CreatedAt   int64        `json:"created_at,omitempty"`
 	FinishedAt  int64        `json:"finished_at,omitempty"` //订单完成时间
 	CanceledAt  int64        `json:"canceled_at,omitempty"`
+
+	// 条件单字段
+	StopPrice       float64 `json:"stop_price,omitempty"`       //触发价格
+	ActivationPrice float64 `json:"activation_price,omitempty"` //跟踪止损单激活价格
+	CallbackRate    float64 `json:"callback_rate,omitempty"`    //跟踪止损单回调幅度(%)
+	WorkingType     string  `json:"working_type,omitempty"`     //触发价格类型: MARK_PRICE / CONTRACT_PRICE
+	PriceProtect    bool    `json:"price_protect,omitempty"`    //是否开启价格保护
+	ClosePosition   bool    `json:"close_position,omitempty"`   //是否触发后全部平仓
+
+	Exact *OrderExact `json:"exact,omitempty"` //精确十进制值，开启model.EnableExactDecimal后填充
+}
+
+// OrderExact 订单价格、数量和手续费的精确十进制值，可以通过OptionParameter{}.ExactQty/ExactPrice原样用于下单
+type OrderExact struct {
+	Price       Decimal `json:"price"`
+	Qty         Decimal `json:"qty"`
+	ExecutedQty Decimal `json:"executed_qty"`
+	PriceAvg    Decimal `json:"price_avg"`
+	Fee         Decimal `json:"fee"`
+	StopPrice   Decimal `json:"stop_price"`
+}
+
+// BatchOrderReq 批量下单中的单个订单参数
+type BatchOrderReq struct {
+	Pair    CurrencyPair      `json:"pair"`
+	Qty     float64           `json:"qty"`
+	Price   float64           `json:"price"`
+	Side    OrderSide         `json:"side"`
+	OrderTy OrderType         `json:"order_ty"`
+	Opts    []OptionParameter `json:"opts,omitempty"`
+}
+
+// BatchOrderResult 批量下单/撤单中单个订单的结果，与请求顺序一一对应
+type BatchOrderResult struct {
+	Order *Order `json:"order,omitempty"` //成功时的订单信息，撤单时只包含订单ID
+	Err   error  `json:"-"`               //该订单失败的原因，成功时为nil
+}
+
+// CommissionRates 测试下单返回的订单手续费率
+type CommissionRates struct {
+	Maker         float64 `json:"maker"`          //标准maker费率
+	Taker         float64 `json:"taker"`          //标准taker费率
+	TaxMaker      float64 `json:"tax_maker"`      //maker税费率
+	TaxTaker      float64 `json:"tax_taker"`      //taker税费率
+	Discount      float64 `json:"discount"`       //使用抵扣资产支付手续费时的折扣
+	DiscountAsset string  `json:"discount_asset"` //抵扣资产，如BNB
+}
+
+// Fill 成交明细，一个订单可能对应多笔成交
+type Fill struct {
+	Id              string    `json:"id"`                     //成交ID
+	OrderId         string    `json:"order_id"`               //订单ID
+	Symbol          string    `json:"symbol"`                 //交易对
+	Side            OrderSide `json:"side,omitempty"`         //交易方向: buy,sell
+	Price           float64   `json:"price"`                  //成交价格
+	Qty             float64   `json:"qty"`                    //成交数量
+	QuoteQty        float64   `json:"quote_qty"`              //成交额
+	Commission      float64   `json:"commission"`             //手续费
+	CommissionAsset string    `json:"commission_asset"`       //手续费币种
+	IsMaker         bool      `json:"is_maker"`               //是否为挂单方
+	RealizedPnl     float64   `json:"realized_pnl,omitempty"` //已实现盈亏，仅期货
+	Time            int64     `json:"time"`                   //成交时间
+
+	Exact *FillExact `json:"exact,omitempty"` //精确十进制值，开启model.EnableExactDecimal后填充
+}
+
+// FillExact 成交明细的精确十进制值，用于累加成交和手续费对账
+type FillExact struct {
+	Price       Decimal `json:"price"`
+	Qty         Decimal `json:"qty"`
+	QuoteQty    Decimal `json:"quote_qty"`
+	Commission  Decimal `json:"commission"`
+	RealizedPnl Decimal `json:"realized_pnl"`
 }
 
 type Account struct {
@@ -160,7 +328,108 @@ type FuturesAccount struct {
 }
 
 type FundingRate struct {
-	Symbol string  `json:"symbol"`
-	Rate   float64 `json:"rate"`
-	Tm     int64   `json:"tm"` //资金费收取时间
+	Symbol          string  `json:"symbol"`
+	Rate            float64 `json:"rate"`
+	Tm              int64   `json:"tm"`                          //资金费收取时间
+	NextFundingTime int64   `json:"next_funding_time,omitempty"` //下次资金费收取时间
+	MarkPrice       float64 `json:"mark_price,omitempty"`        //标记价格
+	IndexPrice      float64 `json:"index_price,omitempty"`       //指数价格
+}
+
+// LeverageBracket 杠杆分层标准
+type LeverageBracket struct {
+	Bracket          int     `json:"bracket"`            //层级
+	InitialLeverage  int     `json:"initial_leverage"`   //该层允许的最高初始杠杆倍数
+	NotionalCap      float64 `json:"notional_cap"`       //该层对应的名义价值上限
+	NotionalFloor    float64 `json:"notional_floor"`     //该层对应的名义价值下限
+	MaintMarginRatio float64 `json:"maint_margin_ratio"` //该层对应的维持保证金率
+	Cum              float64 `json:"cum"`                //速算数
+}
+
+// Income 期货资金流水
+type Income struct {
+	Symbol     string     `json:"symbol,omitempty"`   //交易对，与交易对无关的流水为空
+	IncomeType IncomeType `json:"income_type"`        //流水类型
+	Amount     float64    `json:"amount"`             //金额，正数为收入，负数为支出
+	Asset      string     `json:"asset"`              //资产
+	Info       string     `json:"info,omitempty"`     //备注
+	TranId     string     `json:"tran_id"`            //划转ID，同一流水类型下唯一
+	TradeId    string     `json:"trade_id,omitempty"` //引起流水的成交ID
+	Time       int64      `json:"time"`               //流水时间
+}
+
+// UnifiedAccount 统一账户(Portfolio Margin)汇总信息，金额均折合USD
+type UnifiedAccount struct {
+	UniMMR                   float64 `json:"uni_mmr"`                     //统一账户维持保证金率
+	AccountEquity            float64 `json:"account_equity"`              //以USD计价的账户权益
+	ActualEquity             float64 `json:"actual_equity"`               //不考虑质押率的实际权益
+	AccountInitialMargin     float64 `json:"account_initial_margin"`      //初始保证金
+	AccountMaintMargin       float64 `json:"account_maint_margin"`        //维持保证金
+	AccountStatus            string  `json:"account_status"`              //账户状态: NORMAL、MARGIN_CALL、REDUCE_ONLY等
+	VirtualMaxWithdrawAmount float64 `json:"virtual_max_withdraw_amount"` //以USD计价的最大可转出
+	TotalAvailableBalance    float64 `json:"total_available_balance"`     //可用余额
+	TotalMarginOpenLoss      float64 `json:"total_margin_open_loss"`      //杠杆挂单占用
+	UpdateTime               int64   `json:"update_time"`
+}
+
+// UnifiedBalance 统一账户单个资产的余额，包含杠杆、U本位合约和币本位合约三部分
+type UnifiedBalance struct {
+	Coin                string  `json:"coin"`
+	TotalWalletBalance  float64 `json:"total_wallet_balance"`  //钱包余额
+	CrossMarginAsset    float64 `json:"cross_margin_asset"`    //全仓杠杆资产(含借入)
+	CrossMarginBorrowed float64 `json:"cross_margin_borrowed"` //全仓杠杆借贷
+	CrossMarginFree     float64 `json:"cross_margin_free"`     //全仓杠杆可用
+	CrossMarginInterest float64 `json:"cross_margin_interest"` //全仓杠杆利息
+	CrossMarginLocked   float64 `json:"cross_margin_locked"`   //全仓杠杆冻结
+	UmWalletBalance     float64 `json:"um_wallet_balance"`     //U本位合约钱包余额
+	UmUnrealizedPNL     float64 `json:"um_unrealized_pnl"`     //U本位合约未实现盈亏
+	CmWalletBalance     float64 `json:"cm_wallet_balance"`     //币本位合约钱包余额
+	CmUnrealizedPNL     float64 `json:"cm_unrealized_pnl"`     //币本位合约未实现盈亏
+	NegativeBalance     float64 `json:"negative_balance"`      //负余额
+	UpdateTime          int64   `json:"update_time"`
+}
+
+// MarginAsset 杠杆账户单个资产
+type MarginAsset struct {
+	Coin     string  `json:"coin"`
+	Free     float64 `json:"free"`      //可用
+	Locked   float64 `json:"locked"`    //冻结
+	Borrowed float64 `json:"borrowed"`  //已借
+	Interest float64 `json:"interest"`  //利息
+	NetAsset float64 `json:"net_asset"` //净资产
+}
+
+// MarginAccount 全仓杠杆账户
+type MarginAccount struct {
+	MarginLevel         float64                `json:"margin_level"`           //风险率
+	TotalAssetOfBtc     float64                `json:"total_asset_of_btc"`     //总资产(BTC)
+	TotalLiabilityOfBtc float64                `json:"total_liability_of_btc"` //总负债(BTC)
+	TotalNetAssetOfBtc  float64                `json:"total_net_asset_of_btc"` //净资产(BTC)
+	TradeEnabled        bool                   `json:"trade_enabled"`
+	BorrowEnabled       bool                   `json:"borrow_enabled"`
+	Assets              map[string]MarginAsset `json:"assets"` //键为币种
+}
+
+// IsolatedMarginAccount 逐仓杠杆交易对账户
+type IsolatedMarginAccount struct {
+	Symbol         string      `json:"symbol"`
+	BaseAsset      MarginAsset `json:"base_asset"`
+	QuoteAsset     MarginAsset `json:"quote_asset"`
+	MarginLevel    float64     `json:"margin_level"`    //风险率
+	MarginRatio    float64     `json:"margin_ratio"`    //最大杠杆倍数
+	LiquidatePrice float64     `json:"liquidate_price"` //强平价格
+	IndexPrice     float64     `json:"index_price"`     //指数价格
+	Enabled        bool        `json:"enabled"`         //是否启用
+}
+
+// MarginInterest 杠杆利息记录
+type MarginInterest struct {
+	TxId           string  `json:"tx_id"`
+	Coin           string  `json:"coin"`
+	Principal      float64 `json:"principal"`                 //本金
+	Interest       float64 `json:"interest"`                  //利息
+	InterestRate   float64 `json:"interest_rate"`             //利率
+	Type           string  `json:"type"`                      //计息类型，如PERIODIC、ON_BORROW
+	IsolatedSymbol string  `json:"isolated_symbol,omitempty"` //逐仓交易对，全仓为空
+	Time           int64   `json:"time"`                      //计息时间
 }
@@ -0,0 +1,63 @@
+package model
+
+// OptionSide 期权类型
+type OptionSide string
+
+const (
+	OptionSide_Call OptionSide = "CALL" //看涨期权
+	OptionSide_Put  OptionSide = "PUT"  //看跌期权
+)
+
+// 期权合约参数key，用于NewCurrencyPair定位期权合约
+const (
+	Option_Expiry__Opt_Key = "expiry"     //到期日，格式为YYMMDD，如240628
+	Option_Strike__Opt_Key = "strike"     //行权价
+	Option_Side__Opt_Key   = "optionSide" //期权类型，CALL/PUT，也兼容C/P
+)
+
+// OptionContract 期权合约信息
+type OptionContract struct {
+	Pair        CurrencyPair `json:"pair"`         //Symbol如BTC-240628-60000-C，ContractVal为合约乘数
+	Underlying  string       `json:"underlying"`   //标的，如BTCUSDT
+	StrikePrice float64      `json:"strike_price"` //行权价
+	ExpiryDate  int64        `json:"expiry_date"`  //到期时间(毫秒)
+	OptionSide  OptionSide   `json:"option_side"`  //看涨/看跌
+}
+
+// OptionGreeks 期权希腊值
+type OptionGreeks struct {
+	Underlying string  `json:"underlying,omitempty"` //标的，账户希腊值时有效
+	Delta      float64 `json:"delta"`
+	Gamma      float64 `json:"gamma"`
+	Theta      float64 `json:"theta"`
+	Vega       float64 `json:"vega"`
+}
+
+// OptionMarkPrice 期权标记价格、隐含波动率与希腊值
+type OptionMarkPrice struct {
+	Symbol           string       `json:"symbol"`
+	MarkPrice        float64      `json:"mark_price"`         //标记价格
+	BidIV            float64      `json:"bid_iv"`             //买一隐含波动率
+	AskIV            float64      `json:"ask_iv"`             //卖一隐含波动率
+	MarkIV           float64      `json:"mark_iv"`            //标记隐含波动率
+	Greeks           OptionGreeks `json:"greeks"`             //希腊值
+	HighPriceLimit   float64      `json:"high_price_limit"`   //当前最高买价
+	LowPriceLimit    float64      `json:"low_price_limit"`    //当前最低卖价
+	RiskFreeInterest float64      `json:"risk_free_interest"` //无风险利率
+}
+
+// OptionPosition 期权持仓
+type OptionPosition struct {
+	Pair         CurrencyPair `json:"pair"`
+	PosSide      OrderSide    `json:"pos_side"`      //Futures_OpenBuy为多头(买入期权)，Futures_OpenSell为空头(卖出期权)
+	Qty          float64      `json:"qty"`           //持仓数量，空头为负数
+	ReducibleQty float64      `json:"reducible_qty"` //可平仓数量
+	AvgPx        float64      `json:"avg_px"`        //开仓均价
+	MarkPx       float64      `json:"mark_px"`       //标记价格
+	MarkValue    float64      `json:"mark_value"`    //持仓市值
+	Upl          float64      `json:"upl"`           //未实现盈亏
+	UplRatio     float64      `json:"upl_ratio"`     //收益率
+	StrikePrice  float64      `json:"strike_price"`  //行权价
+	ExpiryDate   int64        `json:"expiry_date"`   //到期时间(毫秒)
+	OptionSide   OptionSide   `json:"option_side"`   //看涨/看跌
+}
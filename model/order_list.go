@@ -0,0 +1,34 @@
+package model
+
+// OrderListStatus 订单列表(OCO/OTO/OTOCO)的状态
+type OrderListStatus string
+
+const (
+	OrderListStatus_Executing OrderListStatus = "EXECUTING" //执行中
+	OrderListStatus_AllDone   OrderListStatus = "ALL_DONE"  //所有订单已完成或取消
+	OrderListStatus_Reject    OrderListStatus = "REJECT"    //被拒绝
+)
+
+// OrderListLeg 订单列表中的单个订单参数
+type OrderListLeg struct {
+	Side        OrderSide `json:"side"`
+	OrderTy     OrderType `json:"order_ty"`
+	Qty         float64   `json:"qty"`
+	Price       float64   `json:"price,omitempty"`         //限价类订单的价格
+	StopPrice   float64   `json:"stop_price,omitempty"`    //条件单的触发价格
+	TimeInForce string    `json:"time_in_force,omitempty"` //限价类订单的时效策略，默认GTC
+	CId         string    `json:"c_id,omitempty"`          //客户端自定义ID
+}
+
+// OrderList 订单列表，如OCO(止盈止损二选一)、OTO(成交后触发)、OTOCO
+type OrderList struct {
+	Pair            CurrencyPair    `json:"pair,omitempty"`
+	Id              string          `json:"id,omitempty"`               //orderListId
+	CId             string          `json:"c_id,omitempty"`             //listClientOrderId
+	ContingencyType string          `json:"contingency_type,omitempty"` //OCO / OTO
+	StatusType      string          `json:"status_type,omitempty"`      //RESPONSE / EXEC_STARTED / UPDATED / ALL_DONE
+	Status          OrderListStatus `json:"status,omitempty"`
+	RejectReason    string          `json:"reject_reason,omitempty"`
+	Orders          []Order         `json:"orders,omitempty"` //订单列表中的各个订单，未返回详情时只有Id和CId
+	CreatedAt       int64           `json:"created_at,omitempty"`
+}
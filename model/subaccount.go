@@ -0,0 +1,59 @@
+package model
+
+// SubAccountType 母子账户万向划转的账户类型
+type SubAccountType string
+
+const (
+	SubAccount_Spot           SubAccountType = "SPOT"            //现货账户
+	SubAccount_USDTFuture     SubAccountType = "USDT_FUTURE"     //U本位合约账户
+	SubAccount_CoinFuture     SubAccountType = "COIN_FUTURE"     //币本位合约账户
+	SubAccount_Margin         SubAccountType = "MARGIN"          //全仓杠杆账户
+	SubAccount_IsolatedMargin SubAccountType = "ISOLATED_MARGIN" //逐仓杠杆账户
+)
+
+// SubAccountFuturesType 子账户合约类型
+type SubAccountFuturesType int
+
+const (
+	SubAccountFutures_UM SubAccountFuturesType = 1 //U本位合约
+	SubAccountFutures_CM SubAccountFuturesType = 2 //币本位合约
+)
+
+// SubAccount 子账户信息
+type SubAccount struct {
+	Email             string `json:"email"`
+	IsFreeze          bool   `json:"is_freeze"`
+	IsManaged         bool   `json:"is_managed,omitempty"`          //是否为托管子账户
+	IsAssetManagement bool   `json:"is_asset_management,omitempty"` //是否为资管子账户
+	CreateTime        int64  `json:"create_time"`
+}
+
+// SubAccountBalance 子账户资产汇总，用于聚合多个账户的资产视图
+type SubAccountBalance struct {
+	Email     string                    `json:"email"`
+	Spot      map[string]Account        `json:"spot,omitempty"`
+	UMFutures map[string]FuturesAccount `json:"um_futures,omitempty"`
+	CMFutures map[string]FuturesAccount `json:"cm_futures,omitempty"`
+}
+
+// SubAccountTransfer 母子账户划转记录
+type SubAccountTransfer struct {
+	TranId          string         `json:"tran_id"`
+	ClientTranId    string         `json:"client_tran_id,omitempty"`
+	FromEmail       string         `json:"from_email,omitempty"` //为空表示母账户
+	ToEmail         string         `json:"to_email,omitempty"`   //为空表示母账户
+	FromAccountType SubAccountType `json:"from_account_type"`
+	ToAccountType   SubAccountType `json:"to_account_type"`
+	Coin            string         `json:"coin"`
+	Amount          float64        `json:"amount"`
+	Status          string         `json:"status"`
+	CreateTime      int64          `json:"create_time"`
+}
+
+// SubAccountApiRestriction 子账户API Key的IP白名单配置
+type SubAccountApiRestriction struct {
+	ApiKey     string   `json:"api_key"`
+	IpRestrict bool     `json:"ip_restrict"` //是否开启IP限制
+	IpList     []string `json:"ip_list,omitempty"`
+	UpdateTime int64    `json:"update_time"`
+}
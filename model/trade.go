@@ -9,3 +9,32 @@ type Trade struct {
 	Direction string       `json:"direction"` // 交易方向，buy或sell
 	Timestamp int64        `json:"timestamp"` // 成交时间戳
 }
+
+// AggTrade 归集成交，同一笔taker订单在同一价格的多笔成交会合并为一条
+type AggTrade struct {
+	Pair         CurrencyPair `json:"pair"`
+	AggId        string       `json:"agg_id"`         // 归集成交ID
+	Price        float64      `json:"price"`          // 成交价格
+	Qty          float64      `json:"qty"`            // 成交数量
+	FirstTradeId string       `json:"first_trade_id"` // 被归集的首个成交ID
+	LastTradeId  string       `json:"last_trade_id"`  // 被归集的末个成交ID
+	IsBuyerMaker bool         `json:"is_buyer_maker"` // 买方是否为maker，true表示主动卖出
+	Timestamp    int64        `json:"timestamp"`      // 成交时间戳
+}
+
+// AvgPrice 当前平均价格
+type AvgPrice struct {
+	Pair      CurrencyPair `json:"pair"`
+	Mins      int          `json:"mins"`       // 平均价格的计算窗口(分钟)
+	Price     float64      `json:"price"`      // 平均价格
+	CloseTime int64        `json:"close_time"` // 最后一笔成交时间
+}
+
+// BookTicker 最优挂单
+type BookTicker struct {
+	Pair     CurrencyPair `json:"pair"`
+	BidPrice float64      `json:"bid_price"` // 买一价
+	BidQty   float64      `json:"bid_qty"`   // 买一量
+	AskPrice float64      `json:"ask_price"` // 卖一价
+	AskQty   float64      `json:"ask_qty"`   // 卖一量
+}
@@ -0,0 +1,147 @@
+package model
+
+// DepositStatus 充值状态
+type DepositStatus int
+
+const (
+	DepositStatus_Pending            DepositStatus = 0 //待确认
+	DepositStatus_Success            DepositStatus = 1 //成功
+	DepositStatus_Rejected           DepositStatus = 2 //已拒绝
+	DepositStatus_Credited           DepositStatus = 6 //已上账但暂不可提现
+	DepositStatus_WrongDeposit       DepositStatus = 7 //错误充值
+	DepositStatus_WaitingUserConfirm DepositStatus = 8 //等待用户确认
+)
+
+func (s DepositStatus) String() string {
+	switch s {
+	case DepositStatus_Pending:
+		return "pending"
+	case DepositStatus_Success:
+		return "success"
+	case DepositStatus_Rejected:
+		return "rejected"
+	case DepositStatus_Credited:
+		return "credited"
+	case DepositStatus_WrongDeposit:
+		return "wrong-deposit"
+	case DepositStatus_WaitingUserConfirm:
+		return "waiting-user-confirm"
+	}
+	return "unknown-status"
+}
+
+// WithdrawStatus 提现状态
+type WithdrawStatus int
+
+const (
+	WithdrawStatus_EmailSent        WithdrawStatus = 0 //已发送确认邮件
+	WithdrawStatus_Cancelled        WithdrawStatus = 1 //已取消
+	WithdrawStatus_AwaitingApproval WithdrawStatus = 2 //等待审核
+	WithdrawStatus_Rejected         WithdrawStatus = 3 //被拒绝
+	WithdrawStatus_Processing       WithdrawStatus = 4 //处理中
+	WithdrawStatus_Failure          WithdrawStatus = 5 //失败
+	WithdrawStatus_Completed        WithdrawStatus = 6 //完成
+)
+
+func (s WithdrawStatus) String() string {
+	switch s {
+	case WithdrawStatus_EmailSent:
+		return "email-sent"
+	case WithdrawStatus_Cancelled:
+		return "cancelled"
+	case WithdrawStatus_AwaitingApproval:
+		return "awaiting-approval"
+	case WithdrawStatus_Rejected:
+		return "rejected"
+	case WithdrawStatus_Processing:
+		return "processing"
+	case WithdrawStatus_Failure:
+		return "failure"
+	case WithdrawStatus_Completed:
+		return "completed"
+	}
+	return "unknown-status"
+}
+
+// WalletAccountType 万向划转的账户类型
+type WalletAccountType string
+
+const (
+	WalletAccount_Spot     WalletAccountType = "MAIN"     //现货账户
+	WalletAccount_UMFuture WalletAccountType = "UMFUTURE" //U本位合约账户
+	WalletAccount_CMFuture WalletAccountType = "CMFUTURE" //币本位合约账户
+	WalletAccount_Margin   WalletAccountType = "MARGIN"   //全仓杠杆账户
+	WalletAccount_Funding  WalletAccountType = "FUNDING"  //资金账户
+)
+
+// TransferType 万向划转类型，如MAIN_UMFUTURE
+func TransferType(from, to WalletAccountType) string {
+	return string(from) + "_" + string(to)
+}
+
+// DepositAddress 充值地址
+type DepositAddress struct {
+	Coin    string `json:"coin"`
+	Network string `json:"network,omitempty"`
+	Address string `json:"address"`
+	Tag     string `json:"tag,omitempty"` //memo/tag
+	Url     string `json:"url,omitempty"` //区块浏览器地址
+}
+
+// Deposit 充值记录
+type Deposit struct {
+	Id           string        `json:"id"`
+	Coin         string        `json:"coin"`
+	Network      string        `json:"network"`
+	Amount       float64       `json:"amount"`
+	Address      string        `json:"address"`
+	Tag          string        `json:"tag,omitempty"`
+	TxId         string        `json:"tx_id"`
+	Status       DepositStatus `json:"status"`
+	ConfirmTimes string        `json:"confirm_times,omitempty"` //确认数，如12/12
+	InsertTime   int64         `json:"insert_time"`
+}
+
+// Withdrawal 提现记录
+type Withdrawal struct {
+	Id              string         `json:"id"`
+	WithdrawOrderId string         `json:"withdraw_order_id,omitempty"` //自定义提现ID
+	Coin            string         `json:"coin"`
+	Network         string         `json:"network"`
+	Amount          float64        `json:"amount"`
+	Fee             float64        `json:"fee"`
+	Address         string         `json:"address"`
+	Tag             string         `json:"tag,omitempty"`
+	TxId            string         `json:"tx_id"`
+	Status          WithdrawStatus `json:"status"`
+	ApplyTime       int64          `json:"apply_time"`              //申请时间(毫秒)
+	CompleteTime    int64          `json:"complete_time,omitempty"` //完成时间(毫秒)
+}
+
+// CoinNetwork 币种在某条网络上的充提信息
+type CoinNetwork struct {
+	Coin                    string  `json:"coin"`
+	Network                 string  `json:"network"`
+	Name                    string  `json:"name"`
+	IsDefault               bool    `json:"is_default"`
+	DepositEnable           bool    `json:"deposit_enable"`
+	WithdrawEnable          bool    `json:"withdraw_enable"`
+	WithdrawFee             float64 `json:"withdraw_fee"`
+	WithdrawMin             float64 `json:"withdraw_min"`
+	WithdrawMax             float64 `json:"withdraw_max"`
+	WithdrawIntegerMultiple float64 `json:"withdraw_integer_multiple"` //提现数量精度
+	MinConfirm              int     `json:"min_confirm"`               //上账所需确认数
+	UnLockConfirm           int     `json:"un_lock_confirm"`           //解锁所需确认数
+	AddressRegex            string  `json:"address_regex,omitempty"`
+	MemoRegex               string  `json:"memo_regex,omitempty"`
+}
+
+// Transfer 万向划转记录
+type Transfer struct {
+	TranId string  `json:"tran_id"`
+	Coin   string  `json:"coin"`
+	Amount float64 `json:"amount"`
+	Type   string  `json:"type"`   //划转类型，如MAIN_UMFUTURE
+	Status string  `json:"status"` //CONFIRMED / FAILED / PENDING
+	Time   int64   `json:"time"`
+}
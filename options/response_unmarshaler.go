@@ -4,6 +4,7 @@ import "github.com/nntaoli-project/goex/v2/model"
 
 type ResponseUnmarshaler func([]byte, interface{}) error
 type GetTickerResponseUnmarshaler func([]byte) (*model.Ticker, error)
+type GetTickersResponseUnmarshaler func([]byte) (map[string]*model.Ticker, error)
 type GetDepthResponseUnmarshaler func([]byte) (*model.Depth, error)
 type GetKlineResponseUnmarshaler func([]byte) ([]model.Kline, error)
 type CreateOrderResponseUnmarshaler func([]byte) (*model.Order, error)
@@ -18,13 +19,51 @@ type GetExchangeInfoResponseUnmarshaler func([]byte) (map[string]model.CurrencyP
 type GetFundingRateResponseUnmarshaler func([]byte) (*model.FundingRate, error)
 type GetFundingRateHistoryResponseUnmarshaler func([]byte) ([]model.FundingRate, error)
 type SetPositionModeResponseUnmarshaler func([]byte) (string, error)
+type GetPositionModeResponseUnmarshaler func([]byte) (string, error)
 type SetLeverageResponseUnmarshaler func([]byte) error
 type GetLeverageResponseUnmarshaler func([]byte) (string, error)
-type AmendOrderResponseUnmarshaler func([]byte) error
+type GetLeverageBracketsResponseUnmarshaler func([]byte) ([]model.LeverageBracket, error)
+type GetIncomeHistoryResponseUnmarshaler func([]byte) ([]model.Income, error)
+type GetFillsResponseUnmarshaler func([]byte) ([]model.Fill, error)
+type GetOptionContractsResponseUnmarshaler func([]byte) (map[string]model.OptionContract, error)
+type GetOptionMarkPricesResponseUnmarshaler func([]byte) ([]model.OptionMarkPrice, error)
+type GetOptionPositionsResponseUnmarshaler func([]byte) ([]model.OptionPosition, error)
+type GetOptionGreeksResponseUnmarshaler func([]byte) ([]model.OptionGreeks, error)
+type GetUnifiedAccountResponseUnmarshaler func([]byte) (*model.UnifiedAccount, error)
+type GetUnifiedBalanceResponseUnmarshaler func([]byte) (map[string]model.UnifiedBalance, error)
+type GetAutoRepayFuturesResponseUnmarshaler func([]byte) (bool, error)
+type GetMarginAccountResponseUnmarshaler func([]byte) (*model.MarginAccount, error)
+type GetIsolatedAccountResponseUnmarshaler func([]byte) (map[string]model.IsolatedMarginAccount, error)
+type GetMaxBorrowableResponseUnmarshaler func([]byte) (float64, error)
+type BorrowRepayResponseUnmarshaler func([]byte) (string, error)
+type GetInterestHistoryResponseUnmarshaler func([]byte) ([]model.MarginInterest, error)
+type GetDepositAddressResponseUnmarshaler func([]byte) (*model.DepositAddress, error)
+type GetDepositHistoryResponseUnmarshaler func([]byte) ([]model.Deposit, error)
+type GetWithdrawHistoryResponseUnmarshaler func([]byte) ([]model.Withdrawal, error)
+type WithdrawResponseUnmarshaler func([]byte) (string, error)
+type GetCoinNetworksResponseUnmarshaler func([]byte) (map[string][]model.CoinNetwork, error)
+type TransferResponseUnmarshaler func([]byte) (string, error)
+type GetTransferHistoryResponseUnmarshaler func([]byte) ([]model.Transfer, error)
+type GetSubAccountsResponseUnmarshaler func([]byte) ([]model.SubAccount, error)
+type GetSubAssetsResponseUnmarshaler func([]byte) (map[string]model.Account, error)
+type GetSubFuturesAccountResponseUnmarshaler func([]byte) (map[string]model.FuturesAccount, error)
+type SubTransferResponseUnmarshaler func([]byte) (string, error)
+type GetSubTransferHistoryResponseUnmarshaler func([]byte) ([]model.SubAccountTransfer, error)
+type EnableSubFuturesResponseUnmarshaler func([]byte) (bool, error)
+type SubApiRestrictionResponseUnmarshaler func([]byte) (*model.SubAccountApiRestriction, error)
+type OrderListResponseUnmarshaler func([]byte) (*model.OrderList, error)
+type GetOrderListsResponseUnmarshaler func([]byte) ([]model.OrderList, error)
+type GetTradesResponseUnmarshaler func([]byte) ([]model.Trade, error)
+type GetAggTradesResponseUnmarshaler func([]byte) ([]model.AggTrade, error)
+type GetAvgPriceResponseUnmarshaler func([]byte) (*model.AvgPrice, error)
+type GetBookTickerResponseUnmarshaler func([]byte) (*model.BookTicker, error)
+type TestOrderResponseUnmarshaler func([]byte) (*model.CommissionRates, error)
+type AmendOrderResponseUnmarshaler func([]byte) (*model.Order, error)
 
 type UnmarshalerOptions struct {
 	ResponseUnmarshaler                      ResponseUnmarshaler
 	TickerUnmarshaler                        GetTickerResponseUnmarshaler
+	TickersUnmarshaler                       GetTickersResponseUnmarshaler
 	DepthUnmarshaler                         GetDepthResponseUnmarshaler
 	KlineUnmarshaler                         GetKlineResponseUnmarshaler
 	CreateOrderResponseUnmarshaler           CreateOrderResponseUnmarshaler
@@ -40,8 +79,45 @@ type UnmarshalerOptions struct {
 	GetFundingRateResponseUnmarshaler        GetFundingRateResponseUnmarshaler
 	GetFundingRateHistoryResponseUnmarshaler GetFundingRateHistoryResponseUnmarshaler
 	SetPositionModeResponseUnmarshaler       SetPositionModeResponseUnmarshaler
+	GetPositionModeResponseUnmarshaler       GetPositionModeResponseUnmarshaler
 	SetLeverageResponseUnmarshaler           SetLeverageResponseUnmarshaler
 	GetLeverageResponseUnmarshaler           GetLeverageResponseUnmarshaler
+	GetLeverageBracketsResponseUnmarshaler   GetLeverageBracketsResponseUnmarshaler
+	GetIncomeHistoryResponseUnmarshaler      GetIncomeHistoryResponseUnmarshaler
+	GetFillsResponseUnmarshaler              GetFillsResponseUnmarshaler
+	GetOptionContractsResponseUnmarshaler    GetOptionContractsResponseUnmarshaler
+	GetOptionMarkPricesResponseUnmarshaler   GetOptionMarkPricesResponseUnmarshaler
+	GetOptionPositionsResponseUnmarshaler    GetOptionPositionsResponseUnmarshaler
+	GetOptionGreeksResponseUnmarshaler       GetOptionGreeksResponseUnmarshaler
+	GetUnifiedAccountResponseUnmarshaler     GetUnifiedAccountResponseUnmarshaler
+	GetUnifiedBalanceResponseUnmarshaler     GetUnifiedBalanceResponseUnmarshaler
+	GetAutoRepayFuturesResponseUnmarshaler   GetAutoRepayFuturesResponseUnmarshaler
+	GetMarginAccountResponseUnmarshaler      GetMarginAccountResponseUnmarshaler
+	GetIsolatedAccountResponseUnmarshaler    GetIsolatedAccountResponseUnmarshaler
+	GetMaxBorrowableResponseUnmarshaler      GetMaxBorrowableResponseUnmarshaler
+	BorrowRepayResponseUnmarshaler           BorrowRepayResponseUnmarshaler
+	GetInterestHistoryResponseUnmarshaler    GetInterestHistoryResponseUnmarshaler
+	GetDepositAddressResponseUnmarshaler     GetDepositAddressResponseUnmarshaler
+	GetDepositHistoryResponseUnmarshaler     GetDepositHistoryResponseUnmarshaler
+	GetWithdrawHistoryResponseUnmarshaler    GetWithdrawHistoryResponseUnmarshaler
+	WithdrawResponseUnmarshaler              WithdrawResponseUnmarshaler
+	GetCoinNetworksResponseUnmarshaler       GetCoinNetworksResponseUnmarshaler
+	TransferResponseUnmarshaler              TransferResponseUnmarshaler
+	GetTransferHistoryResponseUnmarshaler    GetTransferHistoryResponseUnmarshaler
+	GetSubAccountsResponseUnmarshaler        GetSubAccountsResponseUnmarshaler
+	GetSubAssetsResponseUnmarshaler          GetSubAssetsResponseUnmarshaler
+	GetSubFuturesAccountResponseUnmarshaler  GetSubFuturesAccountResponseUnmarshaler
+	SubTransferResponseUnmarshaler           SubTransferResponseUnmarshaler
+	GetSubTransferHistoryResponseUnmarshaler GetSubTransferHistoryResponseUnmarshaler
+	EnableSubFuturesResponseUnmarshaler      EnableSubFuturesResponseUnmarshaler
+	SubApiRestrictionResponseUnmarshaler     SubApiRestrictionResponseUnmarshaler
+	OrderListResponseUnmarshaler             OrderListResponseUnmarshaler
+	GetOrderListsResponseUnmarshaler         GetOrderListsResponseUnmarshaler
+	GetTradesResponseUnmarshaler             GetTradesResponseUnmarshaler
+	GetAggTradesResponseUnmarshaler          GetAggTradesResponseUnmarshaler
+	GetAvgPriceResponseUnmarshaler           GetAvgPriceResponseUnmarshaler
+	GetBookTickerResponseUnmarshaler         GetBookTickerResponseUnmarshaler
+	TestOrderResponseUnmarshaler             TestOrderResponseUnmarshaler
 }
 
 type UnmarshalerOption func(options *UnmarshalerOptions)
@@ -58,6 +134,12 @@ func WithTickerUnmarshaler(unmarshaler GetTickerResponseUnmarshaler) Unmarshaler
 	}
 }
 
+func WithTickersUnmarshaler(unmarshaler GetTickersResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.TickersUnmarshaler = unmarshaler
+	}
+}
+
 func WithDepthUnmarshaler(unmarshaler GetDepthResponseUnmarshaler) UnmarshalerOption {
 	return func(options *UnmarshalerOptions) {
 		options.DepthUnmarshaler = unmarshaler
@@ -147,6 +229,12 @@ func WithSetPositionModeResponseUnmarshaler(unmarshaler SetPositionModeResponseU
 	}
 }
 
+func WithGetPositionModeResponseUnmarshaler(unmarshaler GetPositionModeResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetPositionModeResponseUnmarshaler = unmarshaler
+	}
+}
+
 func WithSetLeveragerResponseUnmarshaler(unmarshaler SetLeverageResponseUnmarshaler) UnmarshalerOption {
 	return func(options *UnmarshalerOptions) {
 		options.SetLeverageResponseUnmarshaler = unmarshaler
@@ -158,3 +246,225 @@ func WithSetLeverageResponseUnmarshaler(unmarshaler SetLeverageResponseUnmarshal
 		options.SetLeverageResponseUnmarshaler = unmarshaler
 	}
 }
+
+func WithGetLeverageResponseUnmarshaler(unmarshaler GetLeverageResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetLeverageResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetLeverageBracketsResponseUnmarshaler(unmarshaler GetLeverageBracketsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetLeverageBracketsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetIncomeHistoryResponseUnmarshaler(unmarshaler GetIncomeHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetIncomeHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetFillsResponseUnmarshaler(unmarshaler GetFillsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetFillsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOptionContractsResponseUnmarshaler(unmarshaler GetOptionContractsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOptionContractsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOptionMarkPricesResponseUnmarshaler(unmarshaler GetOptionMarkPricesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOptionMarkPricesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOptionPositionsResponseUnmarshaler(unmarshaler GetOptionPositionsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOptionPositionsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOptionGreeksResponseUnmarshaler(unmarshaler GetOptionGreeksResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOptionGreeksResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetUnifiedAccountResponseUnmarshaler(unmarshaler GetUnifiedAccountResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetUnifiedAccountResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetUnifiedBalanceResponseUnmarshaler(unmarshaler GetUnifiedBalanceResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetUnifiedBalanceResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetAutoRepayFuturesResponseUnmarshaler(unmarshaler GetAutoRepayFuturesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetAutoRepayFuturesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetMarginAccountResponseUnmarshaler(unmarshaler GetMarginAccountResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetMarginAccountResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetIsolatedAccountResponseUnmarshaler(unmarshaler GetIsolatedAccountResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetIsolatedAccountResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetMaxBorrowableResponseUnmarshaler(unmarshaler GetMaxBorrowableResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetMaxBorrowableResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithBorrowRepayResponseUnmarshaler(unmarshaler BorrowRepayResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.BorrowRepayResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetInterestHistoryResponseUnmarshaler(unmarshaler GetInterestHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetInterestHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetDepositAddressResponseUnmarshaler(unmarshaler GetDepositAddressResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetDepositAddressResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetDepositHistoryResponseUnmarshaler(unmarshaler GetDepositHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetDepositHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetWithdrawHistoryResponseUnmarshaler(unmarshaler GetWithdrawHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetWithdrawHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithWithdrawResponseUnmarshaler(unmarshaler WithdrawResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.WithdrawResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetCoinNetworksResponseUnmarshaler(unmarshaler GetCoinNetworksResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetCoinNetworksResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithTransferResponseUnmarshaler(unmarshaler TransferResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.TransferResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTransferHistoryResponseUnmarshaler(unmarshaler GetTransferHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTransferHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetSubAccountsResponseUnmarshaler(unmarshaler GetSubAccountsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetSubAccountsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetSubAssetsResponseUnmarshaler(unmarshaler GetSubAssetsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetSubAssetsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetSubFuturesAccountResponseUnmarshaler(unmarshaler GetSubFuturesAccountResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetSubFuturesAccountResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithSubTransferResponseUnmarshaler(unmarshaler SubTransferResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.SubTransferResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetSubTransferHistoryResponseUnmarshaler(unmarshaler GetSubTransferHistoryResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetSubTransferHistoryResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithEnableSubFuturesResponseUnmarshaler(unmarshaler EnableSubFuturesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.EnableSubFuturesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithSubApiRestrictionResponseUnmarshaler(unmarshaler SubApiRestrictionResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.SubApiRestrictionResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithOrderListResponseUnmarshaler(unmarshaler OrderListResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.OrderListResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetOrderListsResponseUnmarshaler(unmarshaler GetOrderListsResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetOrderListsResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetTradesResponseUnmarshaler(unmarshaler GetTradesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetTradesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetAggTradesResponseUnmarshaler(unmarshaler GetAggTradesResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetAggTradesResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetAvgPriceResponseUnmarshaler(unmarshaler GetAvgPriceResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetAvgPriceResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithGetBookTickerResponseUnmarshaler(unmarshaler GetBookTickerResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.GetBookTickerResponseUnmarshaler = unmarshaler
+	}
+}
+
+func WithTestOrderResponseUnmarshaler(unmarshaler TestOrderResponseUnmarshaler) UnmarshalerOption {
+	return func(options *UnmarshalerOptions) {
+		options.TestOrderResponseUnmarshaler = unmarshaler
+	}
+}
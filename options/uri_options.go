@@ -3,6 +3,7 @@ package options
 type UriOptions struct {
 	Endpoint                 string
 	TickerUri                string
+	BookTickerUri            string
 	DepthUri                 string
 	KlineUri                 string
 	GetOrderUri              string
@@ -11,14 +12,56 @@ type UriOptions struct {
 	CancelOrderUri           string
 	NewOrderUri              string
 	AmendOrderUri            string
+	BatchOrdersUri           string
 	GetAccountUri            string
+	GetFuturesAccountUri     string
 	GetPositionsUri          string
 	GetExchangeInfoUri       string
 	GetFundingRateUri        string
 	GetFundingRateHistoryUri string
 	SetPositionModeUri       string
+	GetPositionModeUri       string
 	SetLeverageUri           string
 	GetLeverageUri           string
+	GetLeverageBracketUri    string
+	SetMarginTypeUri         string
+	ModifyPositionMarginUri  string
+	GetIncomeHistoryUri      string
+	GetFillsUri              string
+	GetMarkPriceUri          string
+	GetUnifiedAccountUri     string
+	AutoRepayFuturesUri      string
+	RepayNegativeBalanceUri  string
+	BorrowRepayUri           string
+	GetMaxBorrowableUri      string
+	GetIsolatedAccountUri    string
+	GetInterestHistoryUri    string
+	GetDepositAddressUri     string
+	GetDepositHistoryUri     string
+	GetWithdrawHistoryUri    string
+	WithdrawUri              string
+	GetCoinNetworksUri       string
+	TransferUri              string
+	GetSubAccountsUri        string
+	GetSubAssetsUri          string
+	GetSubFuturesAccountUri  string
+	SubTransferUri           string
+	EnableSubFuturesUri      string
+	SubApiRestrictionUri     string
+	SetSubApiRestrictionUri  string
+	DeleteSubApiIpUri        string
+	NewOCOOrderUri           string
+	NewOTOOrderUri           string
+	NewOTOCOOrderUri         string
+	OrderListUri             string
+	OpenOrderListUri         string
+	TradesUri                string
+	HistoricalTradesUri      string
+	AggTradesUri             string
+	AvgPriceUri              string
+	RollingTickerUri         string
+	UIKlineUri               string
+	TestOrderUri             string
 }
 
 type UriOption func(*UriOptions)
@@ -35,6 +78,12 @@ func WithTickerUri(uri string) UriOption {
 	}
 }
 
+func WithBookTickerUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.BookTickerUri = uri
+	}
+}
+
 func WithDepthUri(uri string) UriOption {
 	return func(c *UriOptions) {
 		c.DepthUri = uri
@@ -77,6 +126,12 @@ func WithAmendOrderUri(uri string) UriOption {
 	}
 }
 
+func WithBatchOrdersUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.BatchOrdersUri = uri
+	}
+}
+
 func WithGetHistoryOrdersUri(uri string) UriOption {
 	return func(c *UriOptions) {
 		c.GetHistoryOrdersUri = uri
@@ -89,6 +144,12 @@ func WithGetAccountUri(uri string) UriOption {
 	}
 }
 
+func WithGetFuturesAccountUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFuturesAccountUri = uri
+	}
+}
+
 func WithGetPositionsUri(uri string) UriOption {
 	return func(c *UriOptions) {
 		c.GetPositionsUri = uri
@@ -119,6 +180,12 @@ func WithSetPositionModeUri(uri string) UriOption {
 	}
 }
 
+func WithGetPositionModeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetPositionModeUri = uri
+	}
+}
+
 func WithSetLeverageUri(uri string) UriOption {
 	return func(c *UriOptions) {
 		c.SetLeverageUri = uri
@@ -130,3 +197,237 @@ func WithGetLeverageUri(uri string) UriOption {
 		c.GetLeverageUri = uri
 	}
 }
+
+func WithGetLeverageBracketUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetLeverageBracketUri = uri
+	}
+}
+
+func WithSetMarginTypeUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SetMarginTypeUri = uri
+	}
+}
+
+func WithModifyPositionMarginUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.ModifyPositionMarginUri = uri
+	}
+}
+
+func WithGetIncomeHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetIncomeHistoryUri = uri
+	}
+}
+
+func WithGetFillsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetFillsUri = uri
+	}
+}
+
+func WithGetMarkPriceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetMarkPriceUri = uri
+	}
+}
+
+func WithGetUnifiedAccountUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetUnifiedAccountUri = uri
+	}
+}
+
+func WithAutoRepayFuturesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.AutoRepayFuturesUri = uri
+	}
+}
+
+func WithRepayNegativeBalanceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.RepayNegativeBalanceUri = uri
+	}
+}
+
+func WithBorrowRepayUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.BorrowRepayUri = uri
+	}
+}
+
+func WithGetMaxBorrowableUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetMaxBorrowableUri = uri
+	}
+}
+
+func WithGetIsolatedAccountUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetIsolatedAccountUri = uri
+	}
+}
+
+func WithGetInterestHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetInterestHistoryUri = uri
+	}
+}
+
+func WithGetDepositAddressUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetDepositAddressUri = uri
+	}
+}
+
+func WithGetDepositHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetDepositHistoryUri = uri
+	}
+}
+
+func WithGetWithdrawHistoryUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetWithdrawHistoryUri = uri
+	}
+}
+
+func WithWithdrawUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.WithdrawUri = uri
+	}
+}
+
+func WithGetCoinNetworksUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetCoinNetworksUri = uri
+	}
+}
+
+func WithTransferUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.TransferUri = uri
+	}
+}
+
+func WithGetSubAccountsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetSubAccountsUri = uri
+	}
+}
+
+func WithGetSubAssetsUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetSubAssetsUri = uri
+	}
+}
+
+func WithGetSubFuturesAccountUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.GetSubFuturesAccountUri = uri
+	}
+}
+
+func WithSubTransferUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SubTransferUri = uri
+	}
+}
+
+func WithEnableSubFuturesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.EnableSubFuturesUri = uri
+	}
+}
+
+func WithSubApiRestrictionUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SubApiRestrictionUri = uri
+	}
+}
+
+func WithSetSubApiRestrictionUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.SetSubApiRestrictionUri = uri
+	}
+}
+
+func WithDeleteSubApiIpUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.DeleteSubApiIpUri = uri
+	}
+}
+
+func WithNewOCOOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.NewOCOOrderUri = uri
+	}
+}
+
+func WithNewOTOOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.NewOTOOrderUri = uri
+	}
+}
+
+func WithNewOTOCOOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.NewOTOCOOrderUri = uri
+	}
+}
+
+func WithOrderListUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.OrderListUri = uri
+	}
+}
+
+func WithOpenOrderListUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.OpenOrderListUri = uri
+	}
+}
+
+func WithTradesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.TradesUri = uri
+	}
+}
+
+func WithHistoricalTradesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.HistoricalTradesUri = uri
+	}
+}
+
+func WithAggTradesUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.AggTradesUri = uri
+	}
+}
+
+func WithAvgPriceUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.AvgPriceUri = uri
+	}
+}
+
+func WithRollingTickerUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.RollingTickerUri = uri
+	}
+}
+
+func WithUIKlineUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.UIKlineUri = uri
+	}
+}
+
+func WithTestOrderUri(uri string) UriOption {
+	return func(c *UriOptions) {
+		c.TestOrderUri = uri
+	}
+}
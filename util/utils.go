@@ -11,6 +11,7 @@ import (
 	"github.com/nntaoli-project/goex/v2/model"
 	"github.com/spf13/cast"
 	"io/ioutil"
+	"math"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -23,6 +24,39 @@ func FloatToString(v float64, n int) string {
 	return strconv.FormatFloat(cast.ToFloat64(ret), 'f', -1, 64) //StripTrailingZeros
 }
 
+// StepPrecision 步长的小数位数，如0.001返回3、0.5返回1、10返回0
+func StepPrecision(step float64) int {
+	s := strconv.FormatFloat(step, 'f', -1, 64)
+	if idx := strings.IndexByte(s, '.'); idx >= 0 {
+		return len(s) - idx - 1
+	}
+	return 0
+}
+
+// FloorToStep 向下规整为step的整数倍，step<=0时原样返回
+func FloorToStep(v, step float64) float64 {
+	if step <= 0 {
+		return v
+	}
+	n := v / step
+	if r := math.Round(n); math.Abs(r-n) < 1e-9*math.Max(1, math.Abs(n)) { //消除0.3/0.1=2.9999999999999996这类浮点误差
+		n = r
+	}
+	return cast.ToFloat64(strconv.FormatFloat(math.Floor(n)*step, 'f', StepPrecision(step), 64))
+}
+
+// RoundToStep 四舍五入为step的整数倍，step<=0时原样返回
+func RoundToStep(v, step float64) float64 {
+	if step <= 0 {
+		return v
+	}
+	n := v / step
+	if half := math.Floor(n) + 0.5; math.Abs(half-n) < 1e-9*math.Max(1, math.Abs(n)) { //16.665/0.01=1666.4999999999998时按1666.5处理
+		n = half
+	}
+	return cast.ToFloat64(strconv.FormatFloat(math.Round(n)*step, 'f', StepPrecision(step), 64))
+}
+
 //// IsoTime
 //// Get iso format time
 //// eg: 2018-03-16T18:02:48.284Z
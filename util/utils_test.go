@@ -0,0 +1,69 @@
+package util
+
+import "testing"
+
+func TestStepPrecision(t *testing.T) {
+	tests := []struct {
+		step float64
+		want int
+	}{
+		{0.001, 3},
+		{0.5, 1},
+		{1, 0},
+		{10, 0},
+		{0.00000001, 8},
+	}
+
+	for _, tt := range tests {
+		if got := StepPrecision(tt.step); got != tt.want {
+			t.Errorf("StepPrecision(%v) = %d, want %d", tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestFloorToStep(t *testing.T) {
+	tests := []struct {
+		v, step, want float64
+	}{
+		{1.23456, 0.001, 1.234},
+		{0.3, 0.1, 0.3}, //0.3/0.1=2.9999999999999996
+		{0.7, 0.1, 0.7},
+		{1.0, 0.1, 1.0},
+		{123.456, 10, 120},
+		{1000000.7, 1, 1000000},
+		{0.00001239, 0.00000001, 0.00001239},
+		{0.000012345, 0.00000001, 0.00001234},
+		{5, 0.5, 5},
+		{1.23, 0, 1.23},
+		{1.23, -1, 1.23},
+	}
+
+	for _, tt := range tests {
+		if got := FloorToStep(tt.v, tt.step); got != tt.want {
+			t.Errorf("FloorToStep(%v, %v) = %v, want %v", tt.v, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestRoundToStep(t *testing.T) {
+	tests := []struct {
+		v, step, want float64
+	}{
+		{1.23456, 0.001, 1.235},
+		{1.2344, 0.001, 1.234},
+		{16.665, 0.01, 16.67}, //16.665/0.01=1666.4999999999998
+		{0.3, 0.1, 0.3},
+		{125, 10, 130},
+		{124.9, 10, 120},
+		{0.000012345, 0.00000001, 0.00001235},
+		{1.75, 0.5, 2},
+		{1.23, 0, 1.23},
+		{1.23, -1, 1.23},
+	}
+
+	for _, tt := range tests {
+		if got := RoundToStep(tt.v, tt.step); got != tt.want {
+			t.Errorf("RoundToStep(%v, %v) = %v, want %v", tt.v, tt.step, got, tt.want)
+		}
+	}
+}